@@ -2,172 +2,195 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/manifoldco/promptui"
 	"github.com/spf13/cobra"
+	"urgent-reminder/internal/config"
 	"urgent-reminder/internal/display"
+	"urgent-reminder/internal/i18n"
 	"urgent-reminder/internal/models"
 	"urgent-reminder/internal/service"
 	"urgent-reminder/internal/storage"
+	"urgent-reminder/internal/timeutil"
+)
+
+var (
+	addTemplate string
+	addVars     []string
+	addDate     string
 )
 
 var addCmd = &cobra.Command{
 	Use:   "add",
 	Short: "Add a new reminder",
-	Long:  `Add a new reminder with interactive prompts for title, date, and recurrence options.`,
+	Long: `Add a new reminder with interactive prompts for title, date, and recurrence options.
+
+With --template, the reminder is created from a saved template instead, filling
+its placeholders from --var name=value flags.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if addTemplate == "" && (addDate != "" || len(addVars) > 0) {
+			return i18n.Errorf("--date and --var can only be used with --template")
+		}
+
 		store, err := storage.NewJSONStore()
 		if err != nil {
-			return fmt.Errorf("failed to initialize storage: %w", err)
+			return i18n.Errorf("failed to initialize storage: %w", err)
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			return err
 		}
 
 		reminderService := service.NewReminderService(store)
 		displayObj := display.NewDisplay(noColor)
 
+		if addTemplate != "" {
+			return addFromTemplate(reminderService, displayObj, cfg)
+		}
+
 		titlePrompt := promptui.Prompt{
-			Label: "Title",
+			Label: i18n.T("Title"),
 			Validate: func(input string) error {
 				if input == "" {
-					return fmt.Errorf("title cannot be empty")
+					return i18n.Errorf("title cannot be empty")
 				}
 				return nil
 			},
 		}
 		title, err := titlePrompt.Run()
 		if err != nil {
-			return fmt.Errorf("prompt failed: %w", err)
+			return i18n.Errorf("prompt failed: %w", err)
 		}
 
 		recurrentPrompt := promptui.Select{
-			Label: "Is this reminder recurrent?",
-			Items: []string{"No", "Yes"},
+			Label: i18n.T("Is this reminder recurrent?"),
+			Items: []string{i18n.T("No"), i18n.T("Yes")},
 		}
-		_, isRecurrent, err := recurrentPrompt.Run()
+		isRecurrent, _, err := recurrentPrompt.Run()
 		if err != nil {
-			return fmt.Errorf("prompt failed: %w", err)
+			return i18n.Errorf("prompt failed: %w", err)
 		}
 
 		nextID, err := reminderService.GetNextID()
 		if err != nil {
-			return fmt.Errorf("failed to get next ID: %w", err)
+			return i18n.Errorf("failed to get next ID: %w", err)
 		}
 
 		var reminder *models.Reminder
 
-		if isRecurrent == "No" {
+		if isRecurrent == 0 {
 			datePrompt := promptui.Prompt{
-				Label: "Date (YYYY-MM-DD)",
+				Label: i18n.T("Date (%s)", i18n.DateHint()),
 				Validate: func(input string) error {
-					_, err := time.Parse("2006-01-02", input)
-					if err != nil {
-						return fmt.Errorf("invalid date format, use YYYY-MM-DD")
-					}
-					return nil
+					_, err := i18n.ParseDate(input)
+					return err
 				},
 			}
 			dateStr, err := datePrompt.Run()
 			if err != nil {
-				return fmt.Errorf("prompt failed: %w", err)
+				return i18n.Errorf("prompt failed: %w", err)
 			}
 
-			dueDate, _ := time.Parse("2006-01-02", dateStr)
+			dueDate, _ := i18n.ParseDate(dateStr)
 
 			timePrompt := promptui.Prompt{
-				Label:     "Time (HH:MM, optional, press Enter to skip)",
+				Label:     i18n.T("Time (HH:MM, optional, press Enter to skip)"),
 				IsConfirm: false,
 			}
 			timeStr, err := timePrompt.Run()
 			if err != nil {
-				return fmt.Errorf("prompt failed: %w", err)
+				return i18n.Errorf("prompt failed: %w", err)
 			}
 
 			reminder = models.NewReminder(nextID, title, dueDate)
 			if timeStr != "" {
 				_, err := time.Parse("15:04", timeStr)
 				if err != nil {
-					return fmt.Errorf("invalid time format, use HH:MM")
+					return i18n.Errorf("invalid time format, use HH:MM")
 				}
 				reminder.Time = timeStr
 			}
 		} else {
+			recurrentTypes := []models.RecurrentType{models.RecurrentWeekly, models.RecurrentBiWeekly, models.RecurrentMonthly}
+			recurrentTypeItems := make([]string, len(recurrentTypes))
+			for i, recurrentType := range recurrentTypes {
+				recurrentTypeItems[i] = i18n.T(string(recurrentType))
+			}
 			recurrentTypePrompt := promptui.Select{
-				Label: "Recurrence type",
-				Items: []string{"Weekly", "Bi-weekly", "Monthly"},
+				Label: i18n.T("Recurrence type"),
+				Items: recurrentTypeItems,
 			}
-			_, recurrentTypeStr, err := recurrentTypePrompt.Run()
+			recurrentTypeIdx, _, err := recurrentTypePrompt.Run()
 			if err != nil {
-				return fmt.Errorf("prompt failed: %w", err)
-			}
-
-			var recurrentType models.RecurrentType
-			switch recurrentTypeStr {
-			case "Weekly":
-				recurrentType = models.RecurrentWeekly
-			case "Bi-weekly":
-				recurrentType = models.RecurrentBiWeekly
-			case "Monthly":
-				recurrentType = models.RecurrentMonthly
+				return i18n.Errorf("prompt failed: %w", err)
 			}
+			recurrentType := recurrentTypes[recurrentTypeIdx]
 
 			datePrompt := promptui.Prompt{
-				Label: "Start date (YYYY-MM-DD)",
+				Label: i18n.T("Start date (%s)", i18n.DateHint()),
 				Validate: func(input string) error {
-					_, err := time.Parse("2006-01-02", input)
-					if err != nil {
-						return fmt.Errorf("invalid date format, use YYYY-MM-DD")
-					}
-					return nil
+					_, err := i18n.ParseDate(input)
+					return err
 				},
 			}
 			dateStr, err := datePrompt.Run()
 			if err != nil {
-				return fmt.Errorf("prompt failed: %w", err)
+				return i18n.Errorf("prompt failed: %w", err)
 			}
 
-			dueDate, _ := time.Parse("2006-01-02", dateStr)
+			dueDate, _ := i18n.ParseDate(dateStr)
 
 			reminder = models.NewRecurrentReminder(nextID, title, dueDate, recurrentType)
 
 			if recurrentType == models.RecurrentWeekly || recurrentType == models.RecurrentBiWeekly {
+				dayItems := make([]string, len(models.Weekdays))
+				for i, day := range models.Weekdays {
+					dayItems[i] = i18n.WeekdayName(day)
+				}
 				dayPrompt := promptui.Select{
-					Label: "Select days (multi-select)",
-					Items: []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"},
+					Label: i18n.T("Select days (multi-select)"),
+					Items: dayItems,
+					Searcher: func(input string, index int) bool {
+						return i18n.WeekdayMatches(input, models.Weekdays[index])
+					},
 				}
-				_, day, err := dayPrompt.Run()
+				dayIdx, _, err := dayPrompt.Run()
 				if err != nil {
-					return fmt.Errorf("prompt failed: %w", err)
+					return i18n.Errorf("prompt failed: %w", err)
 				}
-				reminder.RecurrentDays = append(reminder.RecurrentDays, day)
+				reminder.RecurrentDays = append(reminder.RecurrentDays, models.WeekdayCode(models.Weekdays[dayIdx]))
 
 				continuePrompt := promptui.Select{
-					Label: "Add more days?",
-					Items: []string{"No", "Yes"},
+					Label: i18n.T("Add more days?"),
+					Items: []string{i18n.T("No"), i18n.T("Yes")},
 				}
-				_, cont, err := continuePrompt.Run()
-				for cont == "Yes" {
-					_, day, err := dayPrompt.Run()
+				cont, _, err := continuePrompt.Run()
+				for cont == 1 {
+					dayIdx, _, err := dayPrompt.Run()
 					if err != nil {
-						return fmt.Errorf("prompt failed: %w", err)
+						return i18n.Errorf("prompt failed: %w", err)
 					}
-					reminder.RecurrentDays = append(reminder.RecurrentDays, day)
-					_, cont, err = continuePrompt.Run()
+					reminder.RecurrentDays = append(reminder.RecurrentDays, models.WeekdayCode(models.Weekdays[dayIdx]))
+					cont, _, err = continuePrompt.Run()
 				}
 			} else if recurrentType == models.RecurrentMonthly {
 				dayOfMonthPrompt := promptui.Prompt{
-					Label: "Day of month (1-31)",
+					Label: i18n.T("Day of month (1-31)"),
 					Validate: func(input string) error {
 						var day int
 						_, err := fmt.Sscanf(input, "%d", &day)
 						if err != nil || day < 1 || day > 31 {
-							return fmt.Errorf("enter a number between 1 and 31")
+							return i18n.Errorf("enter a number between 1 and 31")
 						}
 						return nil
 					},
 				}
 				dayStr, err := dayOfMonthPrompt.Run()
 				if err != nil {
-					return fmt.Errorf("prompt failed: %w", err)
+					return i18n.Errorf("prompt failed: %w", err)
 				}
 				var dayOfMonth int
 				fmt.Sscanf(dayStr, "%d", &dayOfMonth)
@@ -175,41 +198,263 @@ var addCmd = &cobra.Command{
 			}
 
 			timePrompt := promptui.Prompt{
-				Label:     "Time (HH:MM, optional, press Enter to skip)",
+				Label:     i18n.T("Time (HH:MM, optional, press Enter to skip)"),
 				IsConfirm: false,
 			}
 			timeStr, err := timePrompt.Run()
 			if err != nil {
-				return fmt.Errorf("prompt failed: %w", err)
+				return i18n.Errorf("prompt failed: %w", err)
 			}
 			if timeStr != "" {
 				_, err := time.Parse("15:04", timeStr)
 				if err != nil {
-					return fmt.Errorf("invalid time format, use HH:MM")
+					return i18n.Errorf("invalid time format, use HH:MM")
 				}
 				reminder.Time = timeStr
 			}
 		}
 
-		if err := reminderService.AddReminder(reminder); err != nil {
-			return fmt.Errorf("failed to add reminder: %w", err)
+		scheduledPrompt := promptui.Prompt{
+			Label:    i18n.T("Scheduled start date (%s, optional, press Enter to skip)", i18n.DateHint()),
+			Validate: validateOptionalDate,
+		}
+		scheduledStr, err := scheduledPrompt.Run()
+		if err != nil {
+			return i18n.Errorf("prompt failed: %w", err)
+		}
+		reminder.ScheduledDate = parseOptionalDate(scheduledStr)
+
+		waitPrompt := promptui.Prompt{
+			Label:    i18n.T("Hide until (%s, optional, press Enter to skip)", i18n.DateHint()),
+			Validate: validateOptionalDate,
+		}
+		waitStr, err := waitPrompt.Run()
+		if err != nil {
+			return i18n.Errorf("prompt failed: %w", err)
+		}
+		reminder.WaitUntil = parseOptionalDate(waitStr)
+
+		warnPrompt := promptui.Prompt{
+			Label: i18n.T("Warn before (e.g. 7d,1d,2h, optional, press Enter to skip)"),
+			Validate: func(input string) error {
+				_, err := models.ParseLeadTimes(input)
+				return err
+			},
+		}
+		warnStr, err := warnPrompt.Run()
+		if err != nil {
+			return i18n.Errorf("prompt failed: %w", err)
+		}
+		reminder.WarnBefore, _ = models.ParseLeadTimes(warnStr)
+
+		priorities := []models.Priority{models.PriorityNormal, models.PriorityLow, models.PriorityHigh, models.PriorityCritical}
+		priorityItems := make([]string, len(priorities))
+		for i, priority := range priorities {
+			priorityItems[i] = i18n.T(string(priority))
+		}
+		priorityPrompt := promptui.Select{
+			Label: i18n.T("Priority"),
+			Items: priorityItems,
+		}
+		priorityIdx, _, err := priorityPrompt.Run()
+		if err != nil {
+			return i18n.Errorf("prompt failed: %w", err)
+		}
+		reminder.Priority = priorities[priorityIdx]
+
+		tagsPrompt := promptui.Prompt{
+			Label: i18n.T("Tags (e.g. #ops #billing, optional, press Enter to skip)"),
+		}
+		tagsStr, err := tagsPrompt.Run()
+		if err != nil {
+			return i18n.Errorf("prompt failed: %w", err)
 		}
+		reminder.Tags = models.ParseTags(tagsStr)
 
-		displayObj.PrintSuccess("✓ Reminder added successfully!")
-		displayObj.PrintEmpty()
-		displayObj.PrintInfo(fmt.Sprintf("ID: %d", reminder.ID))
-		displayObj.PrintInfo(fmt.Sprintf("Title: %s", reminder.Title))
-		displayObj.PrintInfo(fmt.Sprintf("Date: %s", reminder.FormatDueDate()))
-		if reminder.Time != "" {
-			displayObj.PrintInfo(fmt.Sprintf("Time: %s", reminder.Time))
+		projectPrompt := promptui.Prompt{
+			Label: i18n.T("Project (optional, press Enter to skip)"),
 		}
-		if reminder.IsRecurrent {
-			displayObj.PrintInfo(fmt.Sprintf("Recurrent: %s", reminder.RecurrentType))
+		project, err := projectPrompt.Run()
+		if err != nil {
+			return i18n.Errorf("prompt failed: %w", err)
+		}
+		reminder.Project = strings.TrimSpace(project)
+
+		for _, field := range cfg.Fields {
+			value, err := promptField(field)
+			if err != nil {
+				return i18n.Errorf("prompt failed: %w", err)
+			}
+			reminder.SetField(field.Name, value)
 		}
+
+		if err := reminderService.AddReminder(reminder); err != nil {
+			return i18n.Errorf("failed to add reminder: %w", err)
+		}
+
+		printAddedReminder(displayObj, reminder)
 		return nil
 	},
 }
 
+func addFromTemplate(reminderService *service.ReminderService, displayObj *display.Display, cfg *config.Config) error {
+	templateStore, err := storage.NewTemplateStore()
+	if err != nil {
+		return i18n.Errorf("failed to initialize template storage: %w", err)
+	}
+
+	tmpl, err := templateStore.LoadTemplate(addTemplate)
+	if err != nil {
+		return err
+	}
+	if err := checkTemplateFields(cfg, tmpl); err != nil {
+		return err
+	}
+
+	vars := map[string]string{}
+	for _, v := range addVars {
+		name, value, ok := strings.Cut(v, "=")
+		if !ok {
+			return i18n.Errorf("invalid variable %q, use name=value", v)
+		}
+		vars[strings.TrimSpace(name)] = value
+	}
+
+	nextID, err := reminderService.GetNextID()
+	if err != nil {
+		return i18n.Errorf("failed to get next ID: %w", err)
+	}
+
+	today := time.Now()
+	dueDate := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	if addDate != "" {
+		dueDate, err = time.Parse("2006-01-02", addDate)
+		if err != nil {
+			return i18n.Errorf("invalid date format, use YYYY-MM-DD")
+		}
+	} else if tmpl.DueIn != "" && !tmpl.IsRecurrent() {
+		dueIn, err := timeutil.ParseDuration(tmpl.DueIn)
+		if err != nil {
+			return i18n.Errorf("template %q: %w", tmpl.Name, err)
+		}
+		dueDate = dueDate.Add(dueIn).Truncate(timeutil.Day)
+	}
+
+	reminder, err := tmpl.Instantiate(nextID, dueDate, vars)
+	if err != nil {
+		return err
+	}
+
+	fields := reminder.Fields
+	reminder.Fields = nil
+	for name, value := range fields {
+		name = strings.ToLower(strings.TrimSpace(name))
+		normalized, err := cfg.NormalizeField(name, value)
+		if err != nil {
+			return i18n.Errorf("template %q: %w", tmpl.Name, err)
+		}
+		reminder.SetField(name, normalized)
+	}
+
+	if reminder.IsRecurrent && addDate == "" {
+		first := reminderService.FirstOccurrence(reminder, today)
+		reminder.DueDate = time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, time.UTC)
+	}
+
+	if err := reminderService.AddReminder(reminder); err != nil {
+		return i18n.Errorf("failed to add reminder: %w", err)
+	}
+
+	printAddedReminder(displayObj, reminder)
+	return nil
+}
+
+func printAddedReminder(displayObj *display.Display, reminder *models.Reminder) {
+	displayObj.PrintSuccess(i18n.T("Reminder added successfully!"))
+	displayObj.PrintEmpty()
+	displayObj.PrintInfo(i18n.T("ID: %d", reminder.ID))
+	displayObj.PrintInfo(i18n.T("Title: %s", reminder.Title))
+	displayObj.PrintInfo(i18n.T("Date: %s", i18n.FormatDate(reminder.DueDate)))
+	if reminder.Time != "" {
+		displayObj.PrintInfo(i18n.T("Time: %s", reminder.Time))
+	}
+	if reminder.IsRecurrent {
+		displayObj.PrintInfo(i18n.T("Recurrent: %s", reminder.RecurrenceDescription()))
+	}
+	if reminder.ScheduledDate != nil {
+		displayObj.PrintInfo(i18n.T("Scheduled: %s", i18n.FormatDate(*reminder.ScheduledDate)))
+	}
+	if reminder.WaitUntil != nil {
+		displayObj.PrintInfo(i18n.T("Hidden until: %s", i18n.FormatDate(*reminder.WaitUntil)))
+	}
+	if len(reminder.WarnBefore) > 0 {
+		displayObj.PrintInfo(i18n.T("Warn before: %s", strings.Join(reminder.WarnBefore, ", ")))
+	}
+	displayObj.PrintInfo(i18n.T("Priority: %s", i18n.T(string(reminder.EffectivePriority()))))
+	if len(reminder.Tags) > 0 {
+		displayObj.PrintInfo(i18n.T("Tags: %s", reminder.FormatTags()))
+	}
+	if reminder.Project != "" {
+		displayObj.PrintInfo(i18n.T("Project: %s", reminder.Project))
+	}
+	for _, name := range reminder.FieldNames() {
+		displayObj.PrintInfo(fmt.Sprintf("%s: %s", name, reminder.Fields[name]))
+	}
+}
+
+func validateOptionalDate(input string) error {
+	if input == "" {
+		return nil
+	}
+	_, err := i18n.ParseDate(input)
+	return err
+}
+
+func parseOptionalDate(input string) *time.Time {
+	if input == "" {
+		return nil
+	}
+	date, err := i18n.ParseDate(input)
+	if err != nil {
+		return nil
+	}
+	return &date
+}
+
+func promptField(field config.FieldDef) (string, error) {
+	if field.Type == config.FieldEnum {
+		fieldPrompt := promptui.Select{
+			Label: field.Name,
+			Items: append([]string{i18n.T("(none)")}, field.Values...),
+		}
+		idx, value, err := fieldPrompt.Run()
+		if err != nil || idx == 0 {
+			return "", err
+		}
+		return value, nil
+	}
+
+	label := field.Name
+	if field.Type != "" && field.Type != config.FieldString {
+		label += " (" + string(field.Type) + ")"
+	}
+	fieldPrompt := promptui.Prompt{
+		Label: i18n.T("%s, optional, press Enter to skip", label),
+		Validate: func(input string) error {
+			_, err := field.Normalize(input)
+			return err
+		},
+	}
+	value, err := fieldPrompt.Run()
+	if err != nil {
+		return "", err
+	}
+	return field.Normalize(value)
+}
+
 func init() {
+	addCmd.Flags().StringVar(&addTemplate, "template", "", "Create the reminder from a saved template")
+	addCmd.Flags().StringArrayVar(&addVars, "var", nil, "Template variable as name=value (repeatable)")
+	addCmd.Flags().StringVar(&addDate, "date", "", "Due or start date (YYYY-MM-DD) when using --template")
 	rootCmd.AddCommand(addCmd)
 }
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"urgent-reminder/internal/display"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/service"
+	"urgent-reminder/internal/storage"
+)
+
+var (
+	blockBy   []string
+	unblockBy []string
+)
+
+var blockCmd = &cobra.Command{
+	Use:   "block [id|title] --by [id|title]",
+	Short: "Mark a reminder as blocked by other reminders",
+	Long: `Mark a reminder as blocked by one or more other reminders. A blocked reminder
+stays out of the due list until all of its blockers are checked.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(blockBy) == 0 {
+			return i18n.Errorf("at least one blocker is required, use --by")
+		}
+
+		store, err := storage.NewJSONStore()
+		if err != nil {
+			return i18n.Errorf("failed to initialize storage: %w", err)
+		}
+
+		reminderService := service.NewReminderService(store)
+		displayObj := display.NewDisplay(noColor)
+
+		reminder, err := resolveConfirmedReminder(reminderService, args[0], false)
+		if err != nil {
+			return i18n.Errorf("failed to get reminder: %w", err)
+		}
+
+		for _, ref := range blockBy {
+			blocker, err := resolveConfirmedReminder(reminderService, ref, false)
+			if err != nil {
+				return i18n.Errorf("failed to get blocker: %w", err)
+			}
+			if err := reminderService.AddBlocker(reminder.ID, blocker.ID); err != nil {
+				return i18n.Errorf("failed to add blocker: %w", err)
+			}
+			displayObj.PrintSuccess(i18n.T("[%d] %s is blocked by [%d] %s", reminder.ID, reminder.Title, blocker.ID, blocker.Title))
+		}
+
+		return nil
+	},
+}
+
+var unblockCmd = &cobra.Command{
+	Use:   "unblock [id|title]",
+	Short: "Remove blockers from a reminder",
+	Long:  `Remove the given blockers from a reminder, or all of them when --by is omitted.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := storage.NewJSONStore()
+		if err != nil {
+			return i18n.Errorf("failed to initialize storage: %w", err)
+		}
+
+		reminderService := service.NewReminderService(store)
+		displayObj := display.NewDisplay(noColor)
+
+		reminder, err := resolveConfirmedReminder(reminderService, args[0], false)
+		if err != nil {
+			return i18n.Errorf("failed to get reminder: %w", err)
+		}
+
+		if len(unblockBy) == 0 {
+			if err := reminderService.ClearBlockers(reminder.ID); err != nil {
+				return i18n.Errorf("failed to remove blockers: %w", err)
+			}
+			displayObj.PrintSuccess(i18n.T("[%d] %s is no longer blocked", reminder.ID, reminder.Title))
+			return nil
+		}
+
+		for _, ref := range unblockBy {
+			blocker, err := resolveConfirmedReminder(reminderService, ref, false)
+			if err != nil {
+				return i18n.Errorf("failed to get blocker: %w", err)
+			}
+			if err := reminderService.RemoveBlocker(reminder.ID, blocker.ID); err != nil {
+				return i18n.Errorf("failed to remove blocker: %w", err)
+			}
+			displayObj.PrintSuccess(i18n.T("[%d] %s is no longer blocked by [%d] %s", reminder.ID, reminder.Title, blocker.ID, blocker.Title))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	blockCmd.Flags().StringSliceVar(&blockBy, "by", nil, "Reminder that must be checked first (repeatable)")
+	unblockCmd.Flags().StringSliceVar(&unblockBy, "by", nil, "Blocker to remove (repeatable, default: all)")
+
+	rootCmd.AddCommand(blockCmd, unblockCmd)
+}
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"urgent-reminder/internal/display"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/output"
+	"urgent-reminder/internal/service"
+	"urgent-reminder/internal/storage"
+)
+
+var calLabels labelFilter
+
+var calCmd = &cobra.Command{
+	Use:   "cal [month]",
+	Short: "Show a month calendar with reminder counts",
+	Long: `Show a month grid with the number of reminders due on each day.
+
+The month defaults to the current one and may be given as YYYY-MM, a month
+number (1-12) or a month name such as "nov". Recurrent reminders are expanded
+into their future occurrences. Today is highlighted and days with overdue
+reminders are shown in red.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, err := selectedOutputFormat()
+		if err != nil {
+			return err
+		}
+
+		query := service.ReminderQuery{}
+		if err := calLabels.apply(&query); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		year, month := now.Year(), now.Month()
+		if len(args) == 1 {
+			year, month, err = parseMonth(args[0], now)
+			if err != nil {
+				return err
+			}
+		}
+
+		store, err := storage.NewJSONStore()
+		if err != nil {
+			return i18n.Errorf("failed to initialize storage: %w", err)
+		}
+
+		reminderService := service.NewReminderService(store)
+		displayObj := display.NewDisplay(noColor)
+
+		first := time.Date(year, month, 1, 0, 0, 0, 0, time.Local)
+		last := first.AddDate(0, 1, -1)
+		occurrences, err := reminderService.OccurrencesBetween(first, last, query)
+		if err != nil {
+			return i18n.Errorf("failed to load reminders: %w", err)
+		}
+		if format != output.FormatText {
+			return writeOccurrences(reminderService, format, occurrences)
+		}
+
+		counts := map[int]int{}
+		overdue := map[int]bool{}
+		for _, occurrence := range occurrences {
+			day := occurrence.Date.Day()
+			counts[day]++
+			if occurrence.Reminder.IsOverdue() && isDueDay(occurrence) {
+				overdue[day] = true
+			}
+		}
+
+		displayObj.PrintMonth(year, month, counts, overdue, now)
+		displayObj.PrintEmpty()
+		displayObj.PrintInfo(i18n.T("Total: %d reminder(s) in %s", len(occurrences), i18n.FormatMonth(year, month)))
+		return nil
+	},
+}
+
+func isDueDay(occurrence service.Occurrence) bool {
+	due := occurrence.Reminder.DueDateTime()
+	return due.Year() == occurrence.Date.Year() && due.YearDay() == occurrence.Date.YearDay()
+}
+
+func parseMonth(input string, now time.Time) (int, time.Month, error) {
+	value := strings.ToLower(strings.TrimSpace(input))
+
+	if t, err := time.ParseInLocation("2006-01", value, time.Local); err == nil {
+		return t.Year(), t.Month(), nil
+	}
+
+	if n, err := strconv.Atoi(value); err == nil && n >= 1 && n <= 12 {
+		return now.Year(), time.Month(n), nil
+	}
+
+	if m, ok := i18n.ParseMonth(value); ok {
+		return now.Year(), m, nil
+	}
+
+	return 0, 0, i18n.Errorf("invalid month %q, use YYYY-MM, 1-12 or a month name", input)
+}
+
+func init() {
+	calLabels.register(calCmd)
+	rootCmd.AddCommand(calCmd)
+}
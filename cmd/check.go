@@ -3,60 +3,225 @@ package cmd
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"urgent-reminder/internal/display"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/models"
 	"urgent-reminder/internal/service"
 	"urgent-reminder/internal/storage"
 )
 
 var checkCmd = &cobra.Command{
-	Use:   "check [id]",
-	Short: "Mark a reminder as complete",
-	Long:  `Mark a reminder as complete. If recurrent, it will advance to the next cycle. If not recurrent, it will be deleted.`,
-	Args:  cobra.ExactArgs(1),
-	RunE: func(cmd *cobra.Command, args []string) error {
-		idStr := args[0]
-		id, err := strconv.Atoi(idStr)
-		if err != nil {
-			return fmt.Errorf("invalid ID: %s", idStr)
-		}
+	Use:   "check [id|title|range]...",
+	Short: "Mark reminders as complete",
+	Long: `Mark one or more reminders as complete. If recurrent, a reminder will advance to the next cycle. If not recurrent, it will be deleted.
 
+Reminders can be given by numeric ID, by ranges such as 3-7 or 1,4,9, or by a
+title that matches exactly one reminder. A title that only partially matches
+needs confirmation. Without arguments, an interactive picker lists the due
+reminders so several can be ticked at once.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
 		store, err := storage.NewJSONStore()
 		if err != nil {
-			return fmt.Errorf("failed to initialize storage: %w", err)
+			return i18n.Errorf("failed to initialize storage: %w", err)
 		}
 
 		reminderService := service.NewReminderService(store)
 		displayObj := display.NewDisplay(noColor)
 
-		reminder, err := reminderService.GetReminder(id)
-		if err != nil {
-			return fmt.Errorf("failed to get reminder: %w", err)
-		}
+		var reminders []*models.Reminder
+		failed := 0
+		total := 0
 
-		if reminder.IsRecurrent {
-			if err := reminderService.CheckReminder(id); err != nil {
-				return fmt.Errorf("failed to update reminder: %w", err)
-			}
-			updatedReminder, err := reminderService.GetReminder(id)
+		if len(args) == 0 {
+			reminders, err = pickDueReminders(reminderService)
 			if err != nil {
-				return fmt.Errorf("failed to get updated reminder: %w", err)
+				return err
+			}
+			if len(reminders) == 0 {
+				displayObj.PrintInfo(i18n.T("No reminders selected."))
+				return nil
 			}
-			displayObj.PrintSuccess("✓ Recurrent reminder advanced to next cycle")
-			displayObj.PrintEmpty()
-			displayObj.PrintInfo(fmt.Sprintf("Next due date: %s", updatedReminder.DueDate.Format("2006-01-02")))
+			total = len(reminders)
 		} else {
-			if err := reminderService.CheckReminder(id); err != nil {
-				return fmt.Errorf("failed to delete reminder: %w", err)
+			refs, err := expandReminderRefs(args)
+			if err != nil {
+				return err
+			}
+			total = len(refs)
+
+			seen := map[int]bool{}
+			for _, ref := range refs {
+				reminder, err := resolveConfirmedReminder(reminderService, ref, false)
+				if err != nil {
+					displayObj.PrintError(fmt.Sprintf("✗ %s: %v", ref, err))
+					failed++
+					continue
+				}
+				if seen[reminder.ID] {
+					continue
+				}
+				seen[reminder.ID] = true
+				reminders = append(reminders, reminder)
+			}
+		}
+
+		for _, reminder := range reminders {
+			if err := checkReminder(reminderService, displayObj, reminder); err != nil {
+				displayObj.PrintError(fmt.Sprintf("✗ [%d] %s: %v", reminder.ID, reminder.Title, err))
+				failed++
 			}
-			displayObj.PrintSuccess("✓ Reminder completed and deleted")
 		}
 
+		if failed > 0 {
+			return i18n.Errorf("%d of %d reminder(s) could not be checked", failed, total)
+		}
 		return nil
 	},
 }
 
+func checkReminder(reminderService *service.ReminderService, displayObj *display.Display, reminder *models.Reminder) error {
+	dependents, err := reminderService.Dependents(reminder.ID)
+	if err != nil {
+		return i18n.Errorf("failed to find dependents: %w", err)
+	}
+
+	if err := reminderService.CheckReminder(reminder.ID); err != nil {
+		if reminder.IsRecurrent {
+			return i18n.Errorf("failed to update reminder: %w", err)
+		}
+		return i18n.Errorf("failed to delete reminder: %w", err)
+	}
+
+	if !reminder.IsRecurrent {
+		displayObj.PrintSuccess(i18n.T("[%d] %s: completed and deleted", reminder.ID, reminder.Title))
+	} else {
+		updatedReminder, err := reminderService.GetReminder(reminder.ID)
+		if err != nil {
+			return i18n.Errorf("failed to get updated reminder: %w", err)
+		}
+		displayObj.PrintSuccess(i18n.T("[%d] %s: advanced to next cycle, next due date %s",
+			reminder.ID, reminder.Title, i18n.FormatDate(updatedReminder.DueDate)))
+	}
+
+	for _, dependent := range dependents {
+		dependent, err := reminderService.GetReminder(dependent.ID)
+		if err != nil {
+			continue
+		}
+		if blocked, err := reminderService.IsBlocked(dependent); err == nil && !blocked {
+			displayObj.PrintInfo(i18n.T("  Unblocked [%d] %s", dependent.ID, dependent.Title))
+		}
+	}
+	return nil
+}
+
+func pickDueReminders(reminderService *service.ReminderService) ([]*models.Reminder, error) {
+	due, err := reminderService.QueryReminders(service.ReminderQuery{SortBy: service.SortByDue})
+	if err != nil {
+		return nil, i18n.Errorf("failed to list reminders: %w", err)
+	}
+	if len(due) == 0 {
+		return nil, nil
+	}
+
+	items := make([]string, len(due))
+	for i, reminder := range due {
+		items[i] = fmt.Sprintf("[%d] %s -- %s", reminder.ID, reminder.Title, i18n.FormatDate(reminder.DueDate))
+	}
+
+	indexes, err := multiSelect(i18n.T("Select reminders to check"), items)
+	if err != nil {
+		return nil, i18n.Errorf("prompt failed: %w", err)
+	}
+
+	var picked []*models.Reminder
+	for _, i := range indexes {
+		picked = append(picked, due[i])
+	}
+	return picked, nil
+}
+
+const (
+	maxRangeSpan    = 1000
+	maxRangeIDDigit = 6
+)
+
+func expandReminderRefs(args []string) ([]string, error) {
+	var refs []string
+	for _, arg := range args {
+		parts := strings.Split(arg, ",")
+		if len(parts) > 1 && !allNumericRefs(parts) {
+			refs = append(refs, arg)
+			continue
+		}
+
+		for _, part := range parts {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+			if start, end, ok := parseIDRange(part); ok {
+				if end-start >= maxRangeSpan {
+					return nil, i18n.Errorf("range %s spans more than %d IDs", part, maxRangeSpan)
+				}
+				for id := start; id <= end; id++ {
+					refs = append(refs, strconv.Itoa(id))
+				}
+				continue
+			}
+			refs = append(refs, part)
+		}
+	}
+	return refs, nil
+}
+
+func allNumericRefs(parts []string) bool {
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if _, err := strconv.Atoi(part); err == nil {
+			continue
+		}
+		if _, _, ok := parseIDRange(part); ok {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+func parseIDRange(part string) (int, int, bool) {
+	bounds := strings.SplitN(part, "-", 2)
+	if len(bounds) != 2 {
+		return 0, 0, false
+	}
+
+	start, ok := parseShortID(bounds[0])
+	if !ok {
+		return 0, 0, false
+	}
+	end, ok := parseShortID(bounds[1])
+	if !ok || end < start {
+		return 0, 0, false
+	}
+	return start, end, true
+}
+
+func parseShortID(value string) (int, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" || len(value) > maxRangeIDDigit {
+		return 0, false
+	}
+	id, err := strconv.Atoi(value)
+	if err != nil || id < 1 {
+		return 0, false
+	}
+	return id, true
+}
+
 func init() {
 	rootCmd.AddCommand(checkCmd)
 }
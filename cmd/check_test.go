@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestExpandReminderRefs(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    []string
+		wantErr bool
+	}{
+		{name: "single id", args: []string{"3"}, want: []string{"3"}},
+		{name: "range", args: []string{"3-6"}, want: []string{"3", "4", "5", "6"}},
+		{name: "list", args: []string{"1,4,7"}, want: []string{"1", "4", "7"}},
+		{name: "list with range", args: []string{"1, 3-4 ,9"}, want: []string{"1", "3", "4", "9"}},
+		{name: "several args", args: []string{"2", "5-6"}, want: []string{"2", "5", "6"}},
+		{name: "single id range", args: []string{"5-5"}, want: []string{"5"}},
+		{name: "reversed range is a title", args: []string{"6-3"}, want: []string{"6-3"}},
+		{name: "title with dash", args: []string{"call-mom"}, want: []string{"call-mom"}},
+		{name: "title with comma", args: []string{"milk, eggs"}, want: []string{"milk, eggs"}},
+		{name: "uuid prefix", args: []string{"1a2b3c4d"}, want: []string{"1a2b3c4d"}},
+		{name: "dated title", args: []string{"2026-01-15"}, want: []string{"2026-01-15"}},
+		{name: "zero start", args: []string{"0-3"}, want: []string{"0-3"}},
+		{name: "span too large", args: []string{"1-1001"}, wantErr: true},
+		{name: "span too large in list", args: []string{"1,5-100000"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expandReminderRefs(tt.args)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expandReminderRefs(%q) returned %d refs, want error", tt.args, len(got))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expandReminderRefs(%q) error: %v", tt.args, err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expandReminderRefs(%q) = %q, want %q", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandReminderRefsLargestRange(t *testing.T) {
+	arg := "1-" + strconv.Itoa(maxRangeSpan)
+	got, err := expandReminderRefs([]string{arg})
+	if err != nil {
+		t.Fatalf("expandReminderRefs(%q) error: %v", arg, err)
+	}
+	if len(got) != maxRangeSpan {
+		t.Errorf("expandReminderRefs(%q) returned %d refs, want %d", arg, len(got), maxRangeSpan)
+	}
+	if got[0] != "1" || got[len(got)-1] != strconv.Itoa(maxRangeSpan) {
+		t.Errorf("expandReminderRefs(%q) = %q ... %q, want 1 ... %d", arg, got[0], got[len(got)-1], maxRangeSpan)
+	}
+}
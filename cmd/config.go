@@ -1,12 +1,13 @@
 package cmd
 
 import (
-	"fmt"
 	"os"
 	"path/filepath"
 
 	"github.com/spf13/cobra"
+	"urgent-reminder/internal/config"
 	"urgent-reminder/internal/display"
+	"urgent-reminder/internal/i18n"
 	"urgent-reminder/internal/storage"
 )
 
@@ -17,18 +18,18 @@ var configListCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		store, err := storage.NewJSONStore()
 		if err != nil {
-			return fmt.Errorf("failed to initialize storage: %w", err)
+			return i18n.Errorf("failed to initialize storage: %w", err)
 		}
 
 		displayObj := display.NewDisplay(noColor)
 
-		displayObj.PrintHeader("Configuration Files")
+		displayObj.PrintHeader(i18n.T("Configuration Files"))
 		displayObj.PrintEmpty()
-		displayObj.PrintInfo(fmt.Sprintf("Data file: %s", store.GetDataPath()))
+		displayObj.PrintInfo(i18n.T("Data file: %s", store.GetDataPath()))
 
 		homeDir, err := os.UserHomeDir()
 		if err != nil {
-			return fmt.Errorf("failed to get home directory: %w", err)
+			return i18n.Errorf("failed to get home directory: %w", err)
 		}
 
 		dataHome := os.Getenv("XDG_DATA_HOME")
@@ -36,10 +37,17 @@ var configListCmd = &cobra.Command{
 			dataHome = filepath.Join(homeDir, ".local", "share")
 		}
 		appDataPath := filepath.Join(dataHome, "urgent-reminder")
-		displayObj.PrintInfo(fmt.Sprintf("Data directory: %s", appDataPath))
+		displayObj.PrintInfo(i18n.T("Data directory: %s", appDataPath))
+
+		configPath, err := config.Path()
+		if err != nil {
+			return err
+		}
+		displayObj.PrintInfo(i18n.T("Config file: %s", configPath))
+		displayObj.PrintInfo(i18n.T("Themes directory: %s", filepath.Join(filepath.Dir(configPath), "themes")))
 
 		displayObj.PrintEmpty()
-		displayObj.PrintInfo("To change data location, set XDG_DATA_HOME:")
+		displayObj.PrintInfo(i18n.T("To change data location, set XDG_DATA_HOME:"))
 		displayObj.PrintInfo("  export XDG_DATA_HOME=/custom/path")
 
 		return nil
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+	"urgent-reminder/internal/display"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/service"
+	"urgent-reminder/internal/storage"
+)
+
+var deleteForce bool
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete [id|title]",
+	Short: "Delete a reminder without completing it",
+	Long: `Delete a reminder. If other reminders are blocked by it, they are listed and a
+confirmation is asked before deleting. A title that only partially matches a
+reminder also needs confirmation. Use --force to skip both.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := storage.NewJSONStore()
+		if err != nil {
+			return i18n.Errorf("failed to initialize storage: %w", err)
+		}
+
+		reminderService := service.NewReminderService(store)
+		displayObj := display.NewDisplay(noColor)
+
+		reminder, err := resolveConfirmedReminder(reminderService, args[0], deleteForce)
+		if err != nil {
+			return i18n.Errorf("failed to get reminder: %w", err)
+		}
+
+		dependents, err := reminderService.Dependents(reminder.ID)
+		if err != nil {
+			return i18n.Errorf("failed to find dependents: %w", err)
+		}
+
+		if len(dependents) > 0 {
+			displayObj.PrintWarning(i18n.T("Warning: [%d] %s is blocking:", reminder.ID, reminder.Title))
+			for _, dependent := range dependents {
+				displayObj.PrintWarning(fmt.Sprintf("  [%d] %s", dependent.ID, dependent.Title))
+			}
+			displayObj.PrintWarning(i18n.T("Deleting it will leave them without this blocker."))
+
+			if !deleteForce {
+				confirmPrompt := promptui.Prompt{
+					Label:     i18n.T("Delete anyway"),
+					IsConfirm: true,
+				}
+				if _, err := confirmPrompt.Run(); err != nil {
+					displayObj.PrintInfo(i18n.T("Aborted."))
+					return nil
+				}
+			}
+		}
+
+		if err := reminderService.DeleteReminder(reminder.ID); err != nil {
+			return i18n.Errorf("failed to delete reminder: %w", err)
+		}
+
+		displayObj.PrintSuccess(i18n.T("Reminder [%d] %s deleted", reminder.ID, reminder.Title))
+		return nil
+	},
+}
+
+func init() {
+	deleteCmd.Flags().BoolVar(&deleteForce, "force", false, "Delete without asking for confirmation")
+	rootCmd.AddCommand(deleteCmd)
+}
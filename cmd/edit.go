@@ -0,0 +1,279 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"urgent-reminder/internal/config"
+	"urgent-reminder/internal/display"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/models"
+	"urgent-reminder/internal/service"
+	"urgent-reminder/internal/storage"
+)
+
+var editCmd = &cobra.Command{
+	Use:   "edit [id|title]",
+	Short: "Edit a reminder in $EDITOR",
+	Long: `Open a reminder in $EDITOR (or $VISUAL) to change its title, due date, time,
+scheduled start date, wait-until date, warning lead times, priority, project, tags, links, custom fields and notes.
+
+The file starts with "Key: value" header lines, one "Link:" line per URL or
+file path and one "Field: name=value" line per custom field. Everything after
+the first blank line is the multi-line notes.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := storage.NewJSONStore()
+		if err != nil {
+			return i18n.Errorf("failed to initialize storage: %w", err)
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			return err
+		}
+
+		reminderService := service.NewReminderService(store)
+		displayObj := display.NewDisplay(noColor)
+
+		reminder, err := resolveConfirmedReminder(reminderService, args[0], false)
+		if err != nil {
+			return i18n.Errorf("failed to get reminder: %w", err)
+		}
+
+		original := renderEditDocument(reminder, cfg)
+		edited, err := editInEditor(original)
+		if err != nil {
+			return err
+		}
+
+		if edited == original {
+			displayObj.PrintInfo(i18n.T("No changes made."))
+			return nil
+		}
+
+		if err := applyEditDocument(reminder, edited, cfg); err != nil {
+			return i18n.Errorf("invalid reminder: %w", err)
+		}
+
+		if err := reminderService.UpdateReminder(reminder); err != nil {
+			return i18n.Errorf("failed to update reminder: %w", err)
+		}
+
+		displayObj.PrintSuccess(i18n.T("Reminder [%d] updated", reminder.ID))
+		return nil
+	},
+}
+
+func renderEditDocument(reminder *models.Reminder, cfg *config.Config) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s\n", i18n.T("Editing reminder [%d]. Lines starting with '#' are ignored in the header.", reminder.ID))
+	fmt.Fprintf(&b, "# %s\n", i18n.T("Use one \"Link:\" line per URL or file path and one \"Field: name=value\" line per custom field."))
+	fmt.Fprintf(&b, "# %s\n", i18n.T("Notes go after the first blank line."))
+	fmt.Fprintf(&b, "Title: %s\n", reminder.Title)
+	fmt.Fprintf(&b, "Due: %s\n", reminder.FormatDueDate())
+	fmt.Fprintf(&b, "Time: %s\n", reminder.Time)
+	fmt.Fprintf(&b, "Scheduled: %s\n", reminder.FormatScheduledDate())
+	fmt.Fprintf(&b, "Wait: %s\n", reminder.FormatWaitUntil())
+	fmt.Fprintf(&b, "Warn: %s\n", strings.Join(reminder.WarnBefore, ", "))
+	fmt.Fprintf(&b, "Priority: %s\n", reminder.EffectivePriority())
+	fmt.Fprintf(&b, "Project: %s\n", reminder.Project)
+	fmt.Fprintf(&b, "Tags: %s\n", reminder.FormatTags())
+	for _, link := range reminder.Links {
+		fmt.Fprintf(&b, "Link: %s\n", link)
+	}
+	for _, field := range cfg.Fields {
+		if _, ok := reminder.Fields[field.Name]; !ok {
+			fmt.Fprintf(&b, "Field: %s=\n", field.Name)
+		}
+	}
+	for _, name := range reminder.FieldNames() {
+		fmt.Fprintf(&b, "Field: %s=%s\n", name, reminder.Fields[name])
+	}
+	b.WriteString("\n")
+	if reminder.Notes != "" {
+		b.WriteString(reminder.Notes)
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+func applyEditDocument(reminder *models.Reminder, document string, cfg *config.Config) error {
+	updated := *reminder
+	updated.Links = nil
+	updated.Fields = nil
+
+	scanner := bufio.NewScanner(strings.NewReader(document))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var notes []string
+	inNotes := false
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if inNotes {
+			notes = append(notes, line)
+			continue
+		}
+		if strings.TrimSpace(line) == "" {
+			inNotes = true
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return i18n.Errorf("header line %q is not in \"Key: value\" form", line)
+		}
+		value = strings.TrimSpace(value)
+
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "title":
+			if value == "" {
+				return i18n.Errorf("title cannot be empty")
+			}
+			updated.Title = value
+		case "due":
+			dueDate, err := time.Parse("2006-01-02", value)
+			if err != nil {
+				return i18n.Errorf("invalid date format, use YYYY-MM-DD")
+			}
+			updated.DueDate = dueDate
+		case "time":
+			if value != "" {
+				if _, err := time.Parse("15:04", value); err != nil {
+					return i18n.Errorf("invalid time format, use HH:MM")
+				}
+			}
+			updated.Time = value
+		case "scheduled", "wait":
+			var date *time.Time
+			if value != "" {
+				parsed, err := time.Parse("2006-01-02", value)
+				if err != nil {
+					return i18n.Errorf("invalid date format, use YYYY-MM-DD")
+				}
+				date = &parsed
+			}
+			if strings.EqualFold(strings.TrimSpace(key), "scheduled") {
+				updated.ScheduledDate = date
+			} else {
+				updated.WaitUntil = date
+			}
+		case "warn":
+			leadTimes, err := models.ParseLeadTimes(value)
+			if err != nil {
+				return err
+			}
+			updated.WarnBefore = leadTimes
+		case "priority":
+			priority, err := models.ParsePriority(value)
+			if err != nil {
+				return err
+			}
+			updated.Priority = priority
+		case "project":
+			updated.Project = value
+		case "tags":
+			updated.Tags = models.ParseTags(value)
+		case "link":
+			if value != "" {
+				updated.Links = append(updated.Links, value)
+			}
+		case "field":
+			name, fieldValue, ok := strings.Cut(value, "=")
+			if !ok {
+				return i18n.Errorf("field line %q is not in \"Field: name=value\" form", line)
+			}
+			name = strings.ToLower(strings.TrimSpace(name))
+			normalized, err := cfg.NormalizeField(name, fieldValue)
+			if err != nil {
+				return err
+			}
+			updated.SetField(name, normalized)
+		default:
+			return i18n.Errorf("unknown header %q", key)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	updated.Notes = strings.TrimRight(strings.Join(notes, "\n"), " \t\n")
+
+	*reminder = updated
+	return nil
+}
+
+func editInEditor(content string) (string, error) {
+	path, err := writeEditFile(content)
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(path)
+
+	editorCmd := editorCommand(path)
+	editorCmd.Stdin = os.Stdin
+	editorCmd.Stdout = os.Stdout
+	editorCmd.Stderr = os.Stderr
+	if err := editorCmd.Run(); err != nil {
+		return "", i18n.Errorf("editor %q failed: %w", editorName(), err)
+	}
+
+	return readEditFile(path)
+}
+
+func editorName() string {
+	editor := strings.TrimSpace(os.Getenv("VISUAL"))
+	if editor == "" {
+		editor = strings.TrimSpace(os.Getenv("EDITOR"))
+	}
+	if editor == "" {
+		editor = "vi"
+	}
+	return editor
+}
+
+func editorCommand(path string) *exec.Cmd {
+	editor := editorName()
+	return exec.Command("sh", "-c", editor+` "$@"`, editor, path)
+}
+
+func writeEditFile(content string) (string, error) {
+	file, err := os.CreateTemp("", "urgent-reminder-*.txt")
+	if err != nil {
+		return "", i18n.Errorf("failed to create temporary file: %w", err)
+	}
+
+	if _, err := file.WriteString(content); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return "", i18n.Errorf("failed to write temporary file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		os.Remove(file.Name())
+		return "", i18n.Errorf("failed to write temporary file: %w", err)
+	}
+	return file.Name(), nil
+}
+
+func readEditFile(path string) (string, error) {
+	edited, err := os.ReadFile(path)
+	if err != nil {
+		return "", i18n.Errorf("failed to read temporary file: %w", err)
+	}
+	return string(edited), nil
+}
+
+func init() {
+	rootCmd.AddCommand(editCmd)
+}
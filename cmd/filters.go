@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/models"
+	"urgent-reminder/internal/service"
+)
+
+type labelFilter struct {
+	tags    []string
+	project string
+	fields  []string
+}
+
+func (f *labelFilter) register(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVar(&f.tags, "tag", nil, "Only include reminders with this tag (repeatable)")
+	cmd.Flags().StringVar(&f.project, "project", "", "Only include reminders in this project")
+	cmd.Flags().StringArrayVar(&f.fields, "field", nil, "Only include reminders whose custom field matches, e.g. ticket=OPS-12 (repeatable)")
+}
+
+func (f *labelFilter) apply(query *service.ReminderQuery) error {
+	for _, tag := range f.tags {
+		query.Tags = append(query.Tags, models.NormalizeTag(tag))
+	}
+	query.Project = f.project
+
+	for _, field := range f.fields {
+		name, value, ok := strings.Cut(field, "=")
+		if !ok {
+			return i18n.Errorf("invalid field filter %q, use name=value", field)
+		}
+		if query.Fields == nil {
+			query.Fields = map[string]string{}
+		}
+		query.Fields[strings.ToLower(strings.TrimSpace(name))] = strings.TrimSpace(value)
+	}
+	return nil
+}
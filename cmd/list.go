@@ -2,59 +2,357 @@ package cmd
 
 import (
 	"fmt"
-	"sort"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"urgent-reminder/internal/config"
 	"urgent-reminder/internal/display"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/models"
+	"urgent-reminder/internal/output"
 	"urgent-reminder/internal/service"
 	"urgent-reminder/internal/storage"
+	"urgent-reminder/internal/timeutil"
+)
+
+var (
+	listAll       bool
+	listOverdue   bool
+	listUpcoming  string
+	listFrom      string
+	listTo        string
+	listRecurrent bool
+	listOneOff    bool
+	listSort      string
+	listWaiting   bool
+	listNotify    bool
+	listLabels    labelFilter
 )
 
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List due reminders",
-	Long:  `List all reminders that are due or overdue.`,
+	Long: `List all reminders that are due or overdue.
+
+Reminders whose scheduled start date has arrived are listed as upcoming even
+before they are due. Reminders with a wait-until date stay hidden until that
+date unless --waiting is given.
+
+Use --all, --upcoming, --overdue or --from/--to to widen or narrow the
+selection, and --sort to change the order (urgency, due, id, title, created).
+Reminders are sorted by urgency by default, which combines priority, how
+overdue a reminder is and how soon it is due.
+
+Tier commands from the config file only run with --notify.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		query, err := buildListQuery()
+		if err != nil {
+			return err
+		}
+
+		format, err := selectedOutputFormat()
+		if err != nil {
+			return err
+		}
+
+		tmpl, err := selectedReminderTemplate()
+		if err != nil {
+			return err
+		}
+
+		columns, err := selectedTableColumns(format)
+		if err != nil {
+			return err
+		}
+
 		store, err := storage.NewJSONStore()
 		if err != nil {
-			return fmt.Errorf("failed to initialize storage: %w", err)
+			return i18n.Errorf("failed to initialize storage: %w", err)
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			return err
 		}
 
 		reminderService := service.NewReminderService(store)
 		displayObj := display.NewDisplay(noColor)
 
-		reminders, err := reminderService.GetDueReminders()
+		sections, err := queryListSections(reminderService, query)
 		if err != nil {
-			return fmt.Errorf("failed to list reminders: %w", err)
+			return i18n.Errorf("failed to list reminders: %w", err)
 		}
 
-		if len(reminders) == 0 {
-			displayObj.PrintInfo("No due reminders found.")
+		if tmpl != nil {
+			for _, section := range sections.named() {
+				if err := writeReminderTemplate(reminderService, tmpl, section.reminders, section.name); err != nil {
+					return err
+				}
+			}
 			return nil
 		}
 
-		sort.Slice(reminders, func(i, j int) bool {
-			return reminders[i].ID < reminders[j].ID
-		})
+		if columns == nil && format != output.FormatText {
+			views, err := sections.views(reminderService)
+			if err != nil {
+				return i18n.Errorf("failed to list reminders: %w", err)
+			}
+			return output.WriteList(os.Stdout, format, views)
+		}
 
-		displayObj.PrintBanner()
-		displayObj.PrintEmpty()
+		if sections.isEmpty() {
+			if !query.IsFiltered() {
+				displayObj.PrintInfo(i18n.T("No due reminders found."))
+			} else {
+				displayObj.PrintInfo(i18n.T("No matching reminders found."))
+			}
+			return nil
+		}
+
+		now := time.Now()
+
+		if columns != nil {
+			reminders := append(append(append([]*models.Reminder{}, sections.due...), sections.upcoming...), sections.blocked...)
+			service.SortReminders(reminders, query.SortBy)
+			displayObj.PrintTable(reminders, columns, now)
+			return nil
+		}
+
+		escalation := escalate(cfg, sections.due, now)
 
-		for _, reminder := range reminders {
-			displayObj.PrintSimpleReminder(
-				reminder.ID,
-				reminder.Title,
-				reminder.FormatDueDate(),
-				reminder.FormatTime(),
-			)
+		if len(sections.due) > 0 || len(sections.upcoming) > 0 {
+			if displayObj.PrintColoredBanner(escalation.top.BannerColor) {
+				displayObj.PrintEmpty()
+			}
 		}
 
-		displayObj.PrintEmpty()
-		displayObj.PrintInfo(fmt.Sprintf("Total: %d URGENT REMINDER(S)", len(reminders)))
+		if escalation.top.Loud {
+			displayObj.PrintLoud(strings.ToUpper(i18n.T("%d reminder(s) %s", escalation.counts[escalation.top.Name], i18n.T(escalation.top.Name))))
+			displayObj.PrintEmpty()
+		}
+
+		if len(sections.due) > 0 {
+			for _, reminder := range sections.due {
+				tier := escalation.tiers[reminder.ID]
+				displayObj.PrintTieredReminder(reminder, tier.Marker, tier.Color, relativeDue(reminder, now))
+			}
+			displayObj.PrintEmpty()
+		}
+
+		if len(sections.upcoming) > 0 {
+			displayObj.PrintInfo(i18n.T("Upcoming:"))
+			for _, reminder := range sections.upcoming {
+				displayObj.PrintUpcomingReminder(reminder, reminder.RelativeDue(now))
+			}
+			displayObj.PrintEmpty()
+		}
+
+		if len(sections.blocked) > 0 {
+			displayObj.PrintWarning(i18n.T("Blocked:"))
+			for _, reminder := range sections.blocked {
+				displayObj.PrintBlockedReminder(reminder)
+			}
+			displayObj.PrintEmpty()
+		}
+
+		if listNotify {
+			for _, reminder := range sections.due {
+				if err := runTierCommand(escalation.tiers[reminder.ID], reminder); err != nil {
+					displayObj.PrintError(i18n.T("tier %q command failed for [%d]: %v", escalation.tiers[reminder.ID].Name, reminder.ID, err))
+				}
+			}
+		}
+
+		switch {
+		case len(sections.upcoming) > 0:
+			displayObj.PrintInfo(i18n.T("Total: %d URGENT REMINDER(S), %d upcoming", len(sections.due), len(sections.upcoming)))
+		case len(sections.due) > 0:
+			displayObj.PrintInfo(i18n.T("Total: %d URGENT REMINDER(S)", len(sections.due)))
+		default:
+			displayObj.PrintInfo(i18n.T("No unblocked reminders found."))
+		}
 		return nil
 	},
 }
 
+type listSections struct {
+	due      []*models.Reminder
+	upcoming []*models.Reminder
+	blocked  []*models.Reminder
+}
+
+func (s listSections) isEmpty() bool {
+	return len(s.due) == 0 && len(s.upcoming) == 0 && len(s.blocked) == 0
+}
+
+type namedSection struct {
+	name      string
+	reminders []*models.Reminder
+}
+
+func (s listSections) named() []namedSection {
+	return []namedSection{
+		{"due", s.due},
+		{"upcoming", s.upcoming},
+		{"blocked", s.blocked},
+	}
+}
+
+func (s listSections) views(reminderService *service.ReminderService) ([]output.Reminder, error) {
+	var views []output.Reminder
+	for _, section := range s.named() {
+		sectionViews, err := reminderViews(reminderService, section.reminders, section.name)
+		if err != nil {
+			return nil, err
+		}
+		views = append(views, sectionViews...)
+	}
+	return views, nil
+}
+
+func queryListSections(reminderService *service.ReminderService, query service.ReminderQuery) (listSections, error) {
+	var sections listSections
+	var err error
+
+	sections.due, err = reminderService.QueryReminders(query)
+	if err != nil {
+		return sections, err
+	}
+
+	if query.IsDefault() {
+		upcomingQuery := query
+		upcomingQuery.Warning = true
+		upcomingQuery.SortBy = service.SortByDue
+		sections.upcoming, err = reminderService.QueryReminders(upcomingQuery)
+		if err != nil {
+			return sections, err
+		}
+	}
+
+	blockedQuery := query
+	blockedQuery.Blocked = service.OnlyBlocked
+	sections.blocked, err = reminderService.QueryReminders(blockedQuery)
+	if err != nil {
+		return sections, err
+	}
+
+	return sections, nil
+}
+
+type escalation struct {
+	tiers  map[int]config.Tier
+	counts map[string]int
+	top    config.Tier
+}
+
+func escalate(cfg *config.Config, reminders []*models.Reminder, now time.Time) escalation {
+	result := escalation{
+		tiers:  map[int]config.Tier{},
+		counts: map[string]int{},
+	}
+
+	topRank := -1
+	for _, reminder := range reminders {
+		tier, ok := cfg.TierFor(reminder, now)
+		if !ok {
+			continue
+		}
+		result.tiers[reminder.ID] = tier
+		result.counts[tier.Name]++
+		if rank := cfg.TierRank(tier.Name); rank > topRank {
+			topRank = rank
+			result.top = tier
+		}
+	}
+	return result
+}
+
+func runTierCommand(tier config.Tier, reminder *models.Reminder) error {
+	if tier.Command == "" {
+		return nil
+	}
+
+	tierCmd := exec.Command("sh", "-c", tier.Command)
+	tierCmd.Env = append(os.Environ(),
+		fmt.Sprintf("URGENT_REMINDER_ID=%d", reminder.ID),
+		"URGENT_REMINDER_UUID="+reminder.UUID,
+		"URGENT_REMINDER_TITLE="+reminder.Title,
+		"URGENT_REMINDER_DUE="+reminder.DueDateTime().Format("2006-01-02 15:04"),
+		"URGENT_REMINDER_TIER="+tier.Name,
+		"URGENT_REMINDER_WHEN="+hookDueDescription(reminder, time.Now()),
+	)
+	tierCmd.Stdout = os.Stderr
+	tierCmd.Stderr = os.Stderr
+	return tierCmd.Run()
+}
+
+func buildListQuery() (service.ReminderQuery, error) {
+	query := service.ReminderQuery{
+		All:            listAll,
+		Overdue:        listOverdue,
+		OnlyRecurrent:  listRecurrent,
+		OnlyOneOff:     listOneOff,
+		IncludeWaiting: listWaiting,
+	}
+
+	if err := listLabels.apply(&query); err != nil {
+		return query, err
+	}
+
+	if listRecurrent && listOneOff {
+		return query, i18n.Errorf("--recurrent and --one-off cannot be used together")
+	}
+
+	if listUpcoming != "" {
+		window, err := timeutil.ParseDuration(listUpcoming)
+		if err != nil {
+			return query, err
+		}
+		query.Upcoming = window
+	}
+
+	if listFrom != "" {
+		from, err := timeutil.ParseDate(listFrom)
+		if err != nil {
+			return query, err
+		}
+		query.From = from
+	}
+
+	if listTo != "" {
+		to, err := timeutil.ParseDate(listTo)
+		if err != nil {
+			return query, err
+		}
+		query.To = to
+	}
+
+	sortBy, err := service.ParseSortField(listSort)
+	if err != nil {
+		return query, err
+	}
+	query.SortBy = sortBy
+
+	return query, nil
+}
+
 func init() {
+	listCmd.Flags().BoolVar(&listAll, "all", false, "Show all reminders, including those not yet due")
+	listCmd.Flags().BoolVar(&listOverdue, "overdue", false, "Show only overdue reminders")
+	listCmd.Flags().StringVar(&listUpcoming, "upcoming", "", "Show reminders due within the given window (e.g. 7d, 2w, 12h), including overdue ones")
+	listCmd.Flags().StringVar(&listFrom, "from", "", "Show reminders due on or after this date (YYYY-MM-DD)")
+	listCmd.Flags().StringVar(&listTo, "to", "", "Show reminders due on or before this date (YYYY-MM-DD)")
+	listCmd.Flags().BoolVar(&listRecurrent, "recurrent", false, "Show only recurrent reminders")
+	listCmd.Flags().BoolVar(&listOneOff, "one-off", false, "Show only one-off reminders")
+	listCmd.Flags().BoolVar(&listWaiting, "waiting", false, "Include reminders hidden until their wait-until date")
+	listCmd.Flags().BoolVar(&listNotify, "notify", false, "Run the tier commands from the config file for due reminders")
+	listLabels.register(listCmd)
+	registerFormatFlag(listCmd)
+	registerColumnsFlag(listCmd)
+	listCmd.Flags().StringVar(&listSort, "sort", string(service.SortByUrgency), "Sort by: urgency, due, id, title, created")
+
 	rootCmd.AddCommand(listCmd)
 }
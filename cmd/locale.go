@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"urgent-reminder/internal/config"
+	"urgent-reminder/internal/i18n"
+)
+
+func applyLocale(cfg *config.Config) {
+	locale, err := i18n.Detect(cfg.Locale)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("Warning: %v, using English", err))
+	}
+	i18n.SetLocale(locale)
+	rootCmd.SetErrPrefix(i18n.T("Error:"))
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"os/exec"
+	"runtime"
+
+	"github.com/spf13/cobra"
+	"urgent-reminder/internal/display"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/service"
+	"urgent-reminder/internal/storage"
+)
+
+var openCmd = &cobra.Command{
+	Use:   "open [id|title]",
+	Short: "Open the first link of a reminder",
+	Long:  `Open the first link (URL or file path) attached to a reminder with xdg-open, or open on macOS.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := storage.NewJSONStore()
+		if err != nil {
+			return i18n.Errorf("failed to initialize storage: %w", err)
+		}
+
+		reminderService := service.NewReminderService(store)
+		displayObj := display.NewDisplay(noColor)
+
+		reminder, err := reminderService.ResolveReminder(args[0])
+		if err != nil {
+			return i18n.Errorf("failed to get reminder: %w", err)
+		}
+
+		if len(reminder.Links) == 0 {
+			return i18n.Errorf("reminder [%d] has no links, add one with 'urgent-reminder edit %d'", reminder.ID, reminder.ID)
+		}
+
+		link := reminder.Links[0]
+		opener := "xdg-open"
+		if runtime.GOOS == "darwin" {
+			opener = "open"
+		}
+
+		if err := exec.Command(opener, link).Start(); err != nil {
+			return i18n.Errorf("failed to open %s: %w", link, err)
+		}
+
+		displayObj.PrintInfo(i18n.T("Opening %s", link))
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(openCmd)
+}
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/spf13/cobra"
+	"urgent-reminder/internal/config"
+	"urgent-reminder/internal/display"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/models"
+	"urgent-reminder/internal/output"
+	"urgent-reminder/internal/service"
+)
+
+var (
+	reminderFormat string
+	tableColumns   string
+)
+
+func registerFormatFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&reminderFormat, "format", "", "Render each reminder with a Go template or a named preset from the config file")
+}
+
+func registerColumnsFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&tableColumns, "columns", "", "Show a table with these columns: id, title, due, time, in, recurrence, tags, priority")
+}
+
+func selectedOutputFormat() (output.Format, error) {
+	return output.ParseFormat(outputFormat)
+}
+
+func selectedReminderTemplate() (*template.Template, error) {
+	if reminderFormat == "" {
+		return nil, nil
+	}
+
+	format, err := selectedOutputFormat()
+	if err != nil {
+		return nil, err
+	}
+	if format != output.FormatText {
+		return nil, i18n.Errorf("--format and --output cannot be used together")
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	if preset, ok := cfg.Format(reminderFormat); ok {
+		return output.ParseTemplate(reminderFormat, preset)
+	}
+	return output.ParseTemplate("--format", output.UnescapeFormat(reminderFormat))
+}
+
+func selectedTableColumns(format output.Format) ([]display.Column, error) {
+	if tableColumns == "" && format != output.FormatTable {
+		return nil, nil
+	}
+	if format != output.FormatText && format != output.FormatTable {
+		return nil, i18n.Errorf("--columns cannot be used with --output %s", format)
+	}
+	return display.ParseColumns(tableColumns)
+}
+
+func reminderViews(reminderService *service.ReminderService, reminders []*models.Reminder, section string) ([]output.Reminder, error) {
+	blocked, err := reminderService.BlockedIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	views := make([]output.Reminder, len(reminders))
+	for i, reminder := range reminders {
+		next := reminderService.UpcomingOccurrences(reminder, now, 1)
+		views[i] = output.NewReminder(reminder, section, blocked[reminder.ID], next, now)
+	}
+	return views, nil
+}
+
+func writeOccurrences(reminderService *service.ReminderService, format output.Format, occurrences []service.Occurrence) error {
+	reminders := make([]*models.Reminder, len(occurrences))
+	for i, occurrence := range occurrences {
+		reminders[i] = occurrence.Reminder
+	}
+	views, err := reminderViews(reminderService, reminders, "")
+	if err != nil {
+		return err
+	}
+
+	records := make([]output.Occurrence, len(occurrences))
+	for i, occurrence := range occurrences {
+		records[i] = output.Occurrence{Date: occurrence.Date.Format("2006-01-02"), Reminder: views[i]}
+	}
+	return output.WriteList(os.Stdout, format, records)
+}
+
+func writeReminderTemplate(reminderService *service.ReminderService, tmpl *template.Template, reminders []*models.Reminder, section string) error {
+	blocked, err := reminderService.BlockedIDs()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	views := make([]output.ReminderView, len(reminders))
+	for i, reminder := range reminders {
+		views[i] = output.NewReminderView(reminder, section, blocked[reminder.ID], now)
+	}
+	return output.WriteTemplate(os.Stdout, tmpl, views)
+}
+
+func relativeDue(reminder *models.Reminder, now time.Time) string {
+	if absoluteTimes {
+		return ""
+	}
+	return reminder.RelativeDue(now)
+}
+
+func hookDueDescription(reminder *models.Reminder, now time.Time) string {
+	if absoluteTimes {
+		return strings.TrimSpace(reminder.FormatDueDate() + " " + reminder.Time)
+	}
+	return i18n.In(i18n.English, func() string { return reminder.RelativeDue(now) })
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"github.com/manifoldco/promptui"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/models"
+	"urgent-reminder/internal/service"
+)
+
+func multiSelect(label string, items []string) ([]int, error) {
+	selected := make([]bool, len(items))
+	cursor := 0
+
+	for {
+		options := []string{i18n.T("Done")}
+		for i, item := range items {
+			mark := "[ ]"
+			if selected[i] {
+				mark = "[x]"
+			}
+			options = append(options, mark+" "+item)
+		}
+
+		prompt := promptui.Select{
+			Label:     label,
+			Items:     options,
+			Size:      10,
+			CursorPos: cursor,
+		}
+		idx, _, err := prompt.Run()
+		if err != nil {
+			return nil, err
+		}
+		if idx == 0 {
+			break
+		}
+
+		selected[idx-1] = !selected[idx-1]
+		cursor = idx
+	}
+
+	var indexes []int
+	for i, isSelected := range selected {
+		if isSelected {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes, nil
+}
+
+func resolveConfirmedReminder(reminderService *service.ReminderService, ref string, force bool) (*models.Reminder, error) {
+	reminder, exact, err := reminderService.ResolveReminderMatch(ref)
+	if err != nil || exact || force {
+		return reminder, err
+	}
+
+	confirmPrompt := promptui.Prompt{
+		Label:     i18n.T("%q only partially matches [%d] %s, use it", ref, reminder.ID, reminder.Title),
+		IsConfirm: true,
+	}
+	if _, err := confirmPrompt.Run(); err != nil {
+		return nil, i18n.Errorf("partial title match %q was not confirmed", ref)
+	}
+	return reminder, nil
+}
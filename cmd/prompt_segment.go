@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/spf13/cobra"
+	"urgent-reminder/internal/config"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/output"
+	"urgent-reminder/internal/service"
+	"urgent-reminder/internal/storage"
+)
+
+const promptCacheName = "prompt"
+
+const (
+	bashPromptSnippet = `# Urgent Reminder prompt segment
+__urgent_reminder_prompt() {
+    local segment
+    segment=$(urgent-reminder prompt 2>/dev/null)
+    [ -n "$segment" ] && printf '[%s] ' "$segment"
+}
+PS1='$(__urgent_reminder_prompt)'"$PS1"
+`
+
+	zshPromptSnippet = `# Urgent Reminder prompt segment
+setopt PROMPT_SUBST
+RPROMPT='$(urgent-reminder prompt 2>/dev/null)'"$RPROMPT"
+`
+
+	starshipPromptSnippet = `# Urgent Reminder prompt segment (add to ~/.config/starship.toml)
+[custom.urgent_reminder]
+description = "Due and overdue reminders"
+command = "urgent-reminder prompt"
+when = "test -n \"$(urgent-reminder prompt 2>/dev/null)\""
+shell = ["sh"]
+style = "bold red"
+format = "[$output]($style) "
+`
+)
+
+var (
+	promptFormat  string
+	promptNoCache bool
+)
+
+var promptCmd = &cobra.Command{
+	Use:   "prompt",
+	Short: "Print a short reminder summary for shell prompts",
+	Long: `Print a tiny summary such as "2 overdue · 1 today" for PS1, RPROMPT or
+starship. Nothing is printed when no reminders are due.
+
+Counts are cached in $XDG_CACHE_HOME/urgent-reminder and recomputed only when
+the reminders file changes or a reminder becomes due, so the command stays fast
+with large stores.
+
+Use --format (or "prompt" in the config file) to shape the segment with a Go
+template over .Overdue, .Today, .Upcoming and .Total, e.g.
+'{{if .Total}}⚠ {{.Total}}{{end}}'.
+
+Run 'urgent-reminder prompt init bash|zsh|starship' for a ready-made snippet.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := storage.NewJSONStore()
+		if err != nil {
+			return i18n.Errorf("failed to initialize storage: %w", err)
+		}
+
+		format := promptFormat
+		if format == "" {
+			cfg, err := config.Load()
+			if err != nil {
+				return err
+			}
+			format = cfg.Prompt
+		}
+
+		summary, err := promptSummary(store, time.Now())
+		if err != nil {
+			return err
+		}
+
+		if format == "" {
+			if segment := defaultPromptSegment(summary); segment != "" {
+				fmt.Println(segment)
+			}
+			return nil
+		}
+
+		tmpl, err := template.New("prompt").Parse(output.UnescapeFormat(format))
+		if err != nil {
+			return i18n.Errorf("invalid prompt format: %w", err)
+		}
+
+		var b strings.Builder
+		if err := tmpl.Execute(&b, summary); err != nil {
+			return i18n.Errorf("failed to render prompt format: %w", err)
+		}
+		if segment := b.String(); segment != "" {
+			fmt.Println(segment)
+		}
+		return nil
+	},
+}
+
+var promptInitCmd = &cobra.Command{
+	Use:       "init [bash|zsh|starship]",
+	Short:     "Print a prompt snippet for bash, zsh or starship",
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: []string{"bash", "zsh", "starship"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		snippets := map[string]string{
+			"bash":     bashPromptSnippet,
+			"zsh":      zshPromptSnippet,
+			"starship": starshipPromptSnippet,
+		}
+
+		snippet, ok := snippets[args[0]]
+		if !ok {
+			return i18n.Errorf("unsupported shell %q, use bash, zsh or starship", args[0])
+		}
+		fmt.Print(snippet)
+		return nil
+	},
+}
+
+func promptSummary(store *storage.JSONStore, now time.Time) (service.Summary, error) {
+	var summary service.Summary
+
+	cache, err := storage.NewCache(promptCacheName, store.GetDataPath())
+	if err != nil {
+		return summary, err
+	}
+	if !promptNoCache && cache.Load(&summary, now) {
+		return summary, nil
+	}
+
+	reminderService := service.NewReminderService(store)
+	summary, validUntil, err := reminderService.Summarize(now)
+	if err != nil {
+		return summary, i18n.Errorf("failed to summarize reminders: %w", err)
+	}
+
+	if err := cache.Save(summary, validUntil); err != nil {
+		return summary, err
+	}
+	return summary, nil
+}
+
+func defaultPromptSegment(summary service.Summary) string {
+	var parts []string
+	if summary.Overdue > 0 {
+		parts = append(parts, i18n.T("%d overdue", summary.Overdue))
+	}
+	if summary.Today > 0 {
+		parts = append(parts, i18n.T("%d today", summary.Today))
+	}
+	return strings.Join(parts, " · ")
+}
+
+func init() {
+	promptCmd.Flags().StringVar(&promptFormat, "format", "", "Go template over .Overdue, .Today, .Upcoming and .Total")
+	promptCmd.Flags().BoolVar(&promptNoCache, "no-cache", false, "Recompute the counts instead of reading the cache")
+
+	promptCmd.AddCommand(promptInitCmd)
+	rootCmd.AddCommand(promptCmd)
+}
@@ -5,10 +5,14 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+	"urgent-reminder/internal/config"
+	"urgent-reminder/internal/output"
 )
 
 var (
-	noColor bool
+	noColor       bool
+	outputFormat  string
+	absoluteTimes bool
 )
 
 var rootCmd = &cobra.Command{
@@ -23,11 +27,37 @@ Data is stored in XDG-compliant locations:
 Commands:
   add         - Add a new reminder (interactive)
   list        - List due reminders
-  check [id]  - Mark a reminder as complete
+  search      - Search reminders by title
+  show [id]   - Show all details of a reminder
+  tags        - List tags with reminder counts
+  cal [month] - Show a month calendar with reminder counts
+  week        - Show an agenda for the current week
+  edit [id]   - Edit a reminder, its notes and links in $EDITOR
+  open [id]   - Open the first link of a reminder
+  sub         - Manage the subtasks of a reminder
+  block [id]  - Mark a reminder as blocked by other reminders
+  unblock [id]- Remove blockers from a reminder
+  delete [id] - Delete a reminder without completing it
+  snooze [id] - Push a reminder's due date back
+  template    - Manage reminder templates
+  check [id]  - Mark reminders as complete (IDs, ranges or interactive)
+  tui         - Browse and manage reminders in a full-screen interface
+  prompt      - Print a short summary for shell prompts
   config-list - List config file locations
   setup       - Setup shell integration`,
 }
 
+func applySettings(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		cfg = config.Default()
+	}
+
+	applyLocale(cfg)
+	applyTheme(cfg)
+	return nil
+}
+
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -36,9 +66,8 @@ func Execute() {
 }
 
 func init() {
+	rootCmd.PersistentPreRunE = applySettings
 	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "Disable colored output")
-
-	if os.Getenv("URGENT_REMINDER_NO_COLOR") == "1" {
-		noColor = true
-	}
+	rootCmd.PersistentFlags().BoolVar(&absoluteTimes, "absolute", false, "Show absolute due dates instead of relative times like \"in 2h 15m\"")
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", string(output.FormatText), "Output format: text, table, json, yaml, csv, tsv, ndjson")
 }
@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"urgent-reminder/internal/display"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/models"
+	"urgent-reminder/internal/output"
+	"urgent-reminder/internal/service"
+	"urgent-reminder/internal/storage"
+)
+
+var (
+	searchRegex  bool
+	searchFuzzy  bool
+	searchSort   string
+	searchLabels labelFilter
+)
+
+var searchCmd = &cobra.Command{
+	Use:   "search <query>",
+	Short: "Search reminders by title",
+	Long: `Search all reminders with a case-insensitive substring match on the title.
+
+Use --regex to match a regular expression or --fuzzy to rank reminders
+whose titles contain the query characters in order. Results keep their
+match order unless --sort is given (urgency, due, id, title, created).`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if searchRegex && searchFuzzy {
+			return i18n.Errorf("--regex and --fuzzy cannot be used together")
+		}
+
+		format, err := selectedOutputFormat()
+		if err != nil {
+			return err
+		}
+
+		tmpl, err := selectedReminderTemplate()
+		if err != nil {
+			return err
+		}
+
+		columns, err := selectedTableColumns(format)
+		if err != nil {
+			return err
+		}
+
+		var sortBy service.SortField
+		if searchSort != "" {
+			sortBy, err = service.ParseSortField(searchSort)
+			if err != nil {
+				return err
+			}
+		}
+
+		mode := service.SearchSubstring
+		if searchRegex {
+			mode = service.SearchRegex
+		} else if searchFuzzy {
+			mode = service.SearchFuzzy
+		}
+
+		store, err := storage.NewJSONStore()
+		if err != nil {
+			return i18n.Errorf("failed to initialize storage: %w", err)
+		}
+
+		reminderService := service.NewReminderService(store)
+		displayObj := display.NewDisplay(noColor)
+
+		query := strings.Join(args, " ")
+		filter := service.ReminderQuery{All: true}
+		if err := searchLabels.apply(&filter); err != nil {
+			return err
+		}
+
+		results, err := reminderService.SearchReminders(query, mode, filter)
+		if err != nil {
+			return i18n.Errorf("failed to search reminders: %w", err)
+		}
+
+		reminders := make([]*models.Reminder, len(results))
+		for i, result := range results {
+			reminders[i] = result.Reminder
+		}
+		if sortBy != "" {
+			service.SortReminders(reminders, sortBy)
+		}
+
+		if tmpl != nil {
+			return writeReminderTemplate(reminderService, tmpl, reminders, "")
+		}
+
+		if columns == nil && format != output.FormatText {
+			views, err := reminderViews(reminderService, reminders, "")
+			if err != nil {
+				return i18n.Errorf("failed to search reminders: %w", err)
+			}
+			return output.WriteList(os.Stdout, format, views)
+		}
+
+		if len(results) == 0 {
+			displayObj.PrintInfo(i18n.T("No reminders match %q.", query))
+			return nil
+		}
+
+		if columns != nil {
+			displayObj.PrintTable(reminders, columns, time.Now())
+			return nil
+		}
+
+		for _, reminder := range reminders {
+			displayObj.PrintStatusReminder(reminder, relativeDue(reminder, time.Now()))
+		}
+
+		displayObj.PrintEmpty()
+		displayObj.PrintInfo(i18n.T("Found: %d reminder(s)", len(reminders)))
+		return nil
+	},
+}
+
+func init() {
+	searchCmd.Flags().BoolVar(&searchRegex, "regex", false, "Treat the query as a regular expression")
+	searchCmd.Flags().BoolVar(&searchFuzzy, "fuzzy", false, "Rank reminders by fuzzy match")
+	searchCmd.Flags().StringVar(&searchSort, "sort", "", "Sort by: urgency, due, id, title, created")
+	searchLabels.register(searchCmd)
+	registerFormatFlag(searchCmd)
+	registerColumnsFlag(searchCmd)
+
+	rootCmd.AddCommand(searchCmd)
+}
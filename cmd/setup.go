@@ -7,13 +7,15 @@ import (
 
 	"github.com/spf13/cobra"
 	"urgent-reminder/internal/display"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/models"
 )
 
 const (
 	zshIntegration = `# Urgent Reminder Integration
 urgent_reminder_list() {
     if command -v urgent-reminder &>/dev/null; then
-        urgent-reminder list 2>/dev/null | grep -q "Total:" && urgent-reminder list
+        urgent-reminder list%[1]s 2>/dev/null | grep -q "Total:" && urgent-reminder list%[1]s%[2]s
     fi
 }
 urgent_reminder_list
@@ -22,23 +24,31 @@ urgent_reminder_list
 	bashIntegration = `# Urgent Reminder Integration
 urgent_reminder_list() {
     if command -v urgent-reminder &>/dev/null; then
-        urgent-reminder list 2>/dev/null | grep -q "Total:" && urgent-reminder list
+        urgent-reminder list%[1]s 2>/dev/null | grep -q "Total:" && urgent-reminder list%[1]s%[2]s
     fi
 }
 urgent_reminder_list
 `
 )
 
+var (
+	setupLabels labelFilter
+	setupNotify bool
+)
+
 var setupCmd = &cobra.Command{
 	Use:   "setup",
 	Short: "Setup shell integration for automatic reminder display",
-	Long:  `Setup shell integration that automatically displays reminders when you open a new terminal. Supports both zsh and bash shells.`,
+	Long: `Setup shell integration that automatically displays reminders when you open a new terminal. Supports both zsh and bash shells.
+
+Use --tag or --project to only greet with matching reminders, e.g. "setup --tag work".
+Use --notify to run the escalation tier commands when the greeting is shown.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		displayObj := display.NewDisplay(noColor)
 
 		homeDir, err := os.UserHomeDir()
 		if err != nil {
-			return fmt.Errorf("failed to get home directory: %w", err)
+			return i18n.Errorf("failed to get home directory: %w", err)
 		}
 
 		shell := os.Getenv("SHELL")
@@ -53,14 +63,14 @@ var setupCmd = &cobra.Command{
 			shellName = "bash"
 		}
 
-		displayObj.PrintInfo(fmt.Sprintf("Detected shell: %s", shellName))
-		displayObj.PrintInfo(fmt.Sprintf("Config file: %s", configFile))
+		displayObj.PrintInfo(i18n.T("Detected shell: %s", shellName))
+		displayObj.PrintInfo(i18n.T("Config file: %s", configFile))
 		displayObj.PrintEmpty()
 
 		content, err := os.ReadFile(configFile)
 		if err != nil {
 			if !os.IsNotExist(err) {
-				return fmt.Errorf("failed to read config file: %w", err)
+				return i18n.Errorf("failed to read config file: %w", err)
 			}
 			content = []byte{}
 		}
@@ -68,21 +78,34 @@ var setupCmd = &cobra.Command{
 		contentStr := string(content)
 
 		if strings.Contains(contentStr, "urgent_reminder_list") {
-			displayObj.PrintWarning("Shell integration already configured!")
+			displayObj.PrintWarning(i18n.T("Shell integration already configured!"))
 			displayObj.PrintEmpty()
-			displayObj.PrintInfo("The urgent_reminder_list function is already in your shell config.")
+			displayObj.PrintInfo(i18n.T("The urgent_reminder_list function is already in your shell config."))
 			displayObj.PrintEmpty()
-			displayObj.PrintInfo("To apply changes (if you just set this up manually):")
+			displayObj.PrintInfo(i18n.T("To apply changes (if you just set this up manually):"))
 			displayObj.PrintInfo(fmt.Sprintf("  source ~/.%src", shellName))
-			displayObj.PrintInfo("  # or restart your terminal")
+			displayObj.PrintInfo(i18n.T("  # or restart your terminal"))
 			return nil
 		}
 
+		var listArgs string
+		for _, tag := range setupLabels.tags {
+			listArgs += " --tag " + shellQuote(models.NormalizeTag(tag))
+		}
+		if setupLabels.project != "" {
+			listArgs += " --project " + shellQuote(setupLabels.project)
+		}
+
+		var notifyArg string
+		if setupNotify {
+			notifyArg = " --notify"
+		}
+
 		var integration string
 		if shellName == "zsh" {
-			integration = zshIntegration
+			integration = fmt.Sprintf(zshIntegration, listArgs, notifyArg)
 		} else {
-			integration = bashIntegration
+			integration = fmt.Sprintf(bashIntegration, listArgs, notifyArg)
 		}
 
 		if contentStr != "" && !strings.HasSuffix(contentStr, "\n") {
@@ -91,33 +114,45 @@ var setupCmd = &cobra.Command{
 
 		file, err := os.OpenFile(configFile, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0644)
 		if err != nil {
-			return fmt.Errorf("failed to open config file: %w", err)
+			return i18n.Errorf("failed to open config file: %w", err)
 		}
 		defer file.Close()
 
 		if _, err := file.WriteString(integration); err != nil {
-			return fmt.Errorf("failed to write to config file: %w", err)
+			return i18n.Errorf("failed to write to config file: %w", err)
 		}
 
-		displayObj.PrintSuccess("✓ Shell integration configured successfully!")
+		displayObj.PrintSuccess(i18n.T("Shell integration configured successfully!"))
 		displayObj.PrintEmpty()
-		displayObj.PrintInfo("The urgent_reminder_list function has been added to your shell config.")
+		displayObj.PrintInfo(i18n.T("The urgent_reminder_list function has been added to your shell config."))
 		displayObj.PrintEmpty()
-		displayObj.PrintInfo("To apply changes:")
+		displayObj.PrintInfo(i18n.T("To apply changes:"))
 		displayObj.PrintInfo(fmt.Sprintf("  source ~/.%src", shellName))
-		displayObj.PrintInfo("  # or restart your terminal")
+		displayObj.PrintInfo(i18n.T("  # or restart your terminal"))
 		displayObj.PrintEmpty()
-		displayObj.PrintInfo("This will automatically run 'urgent-reminder list' in new terminals.")
+		displayObj.PrintInfo(i18n.T("This will automatically run 'urgent-reminder list%s' in new terminals.", listArgs+notifyArg))
 
 		return nil
 	},
 }
 
+func shellQuote(value string) string {
+	if value != "" && strings.IndexFunc(value, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_./:@", r))
+	}) < 0 {
+		return value
+	}
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
 func fileExists(path string) bool {
 	_, err := os.Stat(path)
 	return err == nil
 }
 
 func init() {
+	setupCmd.Flags().StringSliceVar(&setupLabels.tags, "tag", nil, "Only greet with reminders carrying this tag (repeatable)")
+	setupCmd.Flags().StringVar(&setupLabels.project, "project", "", "Only greet with reminders in this project")
+	setupCmd.Flags().BoolVar(&setupNotify, "notify", false, "Run tier commands when the greeting is shown")
 	rootCmd.AddCommand(setupCmd)
 }
@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"urgent-reminder/internal/display"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/models"
+	"urgent-reminder/internal/output"
+	"urgent-reminder/internal/service"
+	"urgent-reminder/internal/storage"
+)
+
+var showCmd = &cobra.Command{
+	Use:   "show [id|title]",
+	Short: "Show all details of a reminder",
+	Long:  `Show every field of a reminder, a description of its recurrence, its next occurrences and how long it has been overdue.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, err := selectedOutputFormat()
+		if err != nil {
+			return err
+		}
+
+		tmpl, err := selectedReminderTemplate()
+		if err != nil {
+			return err
+		}
+
+		store, err := storage.NewJSONStore()
+		if err != nil {
+			return i18n.Errorf("failed to initialize storage: %w", err)
+		}
+
+		reminderService := service.NewReminderService(store)
+		displayObj := display.NewDisplay(noColor)
+
+		reminder, err := reminderService.ResolveReminder(args[0])
+		if err != nil {
+			return i18n.Errorf("failed to get reminder: %w", err)
+		}
+
+		if tmpl != nil {
+			return writeReminderTemplate(reminderService, tmpl, []*models.Reminder{reminder}, "")
+		}
+
+		if format != output.FormatText {
+			views, err := reminderViews(reminderService, []*models.Reminder{reminder}, "")
+			if err != nil {
+				return i18n.Errorf("failed to get reminder: %w", err)
+			}
+			return output.WriteOne(os.Stdout, format, views[0])
+		}
+
+		displayObj.PrintHeader(fmt.Sprintf("[%d] %s", reminder.ID, reminder.Title))
+		displayObj.PrintField("ID", fmt.Sprintf("%d", reminder.ID))
+		displayObj.PrintField("UUID", reminder.UUID)
+		displayObj.PrintField(i18n.T("Title"), reminder.Title)
+		displayObj.PrintField(i18n.T("Due date"), i18n.FormatDate(reminder.DueDate))
+		if reminder.Time != "" {
+			displayObj.PrintField(i18n.T("Time"), reminder.Time)
+		}
+		if reminder.Project != "" {
+			displayObj.PrintField(i18n.T("Project"), reminder.Project)
+		}
+		if len(reminder.Tags) > 0 {
+			displayObj.PrintField(i18n.T("Tags"), reminder.FormatTags())
+		}
+		for _, name := range reminder.FieldNames() {
+			displayObj.PrintField(name, reminder.Fields[name])
+		}
+		displayObj.PrintField(i18n.T("Priority"), i18n.T(string(reminder.EffectivePriority())))
+		displayObj.PrintField(i18n.T("Urgency"), fmt.Sprintf("%.2f", reminder.Urgency(time.Now())))
+		if reminder.ScheduledDate != nil {
+			displayObj.PrintField(i18n.T("Scheduled"), i18n.FormatDate(*reminder.ScheduledDate))
+		}
+		if reminder.WaitUntil != nil {
+			displayObj.PrintField(i18n.T("Hidden until"), i18n.FormatDate(*reminder.WaitUntil))
+		}
+		displayObj.PrintField(i18n.T("Status"), i18n.T(reminder.DueStatus()))
+		if len(reminder.WarnBefore) > 0 {
+			displayObj.PrintField(i18n.T("Warn before"), strings.Join(reminder.WarnBefore, ", "))
+		}
+		if absoluteTimes {
+			if overdueBy := reminder.OverdueBy(time.Now()); overdueBy != "" {
+				displayObj.PrintField(i18n.T("Overdue by"), overdueBy)
+			}
+		} else {
+			displayObj.PrintField(i18n.T("When"), reminder.RelativeDue(time.Now()))
+		}
+
+		if len(reminder.BlockedBy) > 0 {
+			displayObj.PrintField(i18n.T("Blocked by"), formatReminderRefs(reminderService, reminder.BlockedBy))
+		}
+		if dependents, err := reminderService.Dependents(reminder.ID); err == nil && len(dependents) > 0 {
+			ids := make([]int, len(dependents))
+			for i, dependent := range dependents {
+				ids[i] = dependent.ID
+			}
+			displayObj.PrintField(i18n.T("Blocking"), formatReminderRefs(reminderService, ids))
+		}
+
+		displayObj.PrintField(i18n.T("Recurrent"), yesNo(reminder.IsRecurrent))
+		if reminder.IsRecurrent {
+			displayObj.PrintField(i18n.T("Recurrence"), i18n.T(string(reminder.RecurrentType)))
+			if len(reminder.RecurrentDays) > 0 {
+				displayObj.PrintField(i18n.T("Days"), reminder.FormatDays())
+			}
+			if reminder.RecurrentDayOfMonth > 0 {
+				displayObj.PrintField(i18n.T("Day of month"), fmt.Sprintf("%d", reminder.RecurrentDayOfMonth))
+			}
+		}
+		displayObj.PrintField(i18n.T("Repeats"), reminder.RecurrenceDescription())
+		displayObj.PrintField(i18n.T("Created"), i18n.FormatDateTime(reminder.CreatedAt.Local(), reminder.CreatedAt.Local().Format("15:04")))
+
+		if done, total := reminder.SubtaskProgress(); total > 0 {
+			displayObj.PrintEmpty()
+			displayObj.PrintInfo(i18n.T("Subtasks (%d/%d):", done, total))
+			for i, subtask := range reminder.Subtasks {
+				fmt.Printf("  %d. [%s] %s\n", i+1, displayObj.SubtaskMark(subtask.Done), subtask.Title)
+			}
+		}
+
+		if len(reminder.Links) > 0 {
+			displayObj.PrintEmpty()
+			displayObj.PrintInfo(i18n.T("Links:"))
+			for i, link := range reminder.Links {
+				displayObj.PrintInfo(fmt.Sprintf("  %d. %s", i+1, link))
+			}
+		}
+
+		if reminder.Notes != "" {
+			displayObj.PrintEmpty()
+			displayObj.PrintInfo(i18n.T("Notes:"))
+			for _, line := range strings.Split(reminder.Notes, "\n") {
+				fmt.Println("  " + line)
+			}
+		}
+
+		if reminder.IsRecurrent {
+			displayObj.PrintEmpty()
+			displayObj.PrintInfo(i18n.T("Next occurrences:"))
+			for _, occurrence := range reminderService.UpcomingOccurrences(reminder, time.Now(), 3) {
+				displayObj.PrintInfo("  " + i18n.FormatWeekdayDate(occurrence))
+			}
+		}
+
+		return nil
+	},
+}
+
+func formatReminderRefs(reminderService *service.ReminderService, ids []int) string {
+	refs := make([]string, len(ids))
+	for i, id := range ids {
+		if reminder, err := reminderService.GetReminder(id); err == nil {
+			refs[i] = fmt.Sprintf("[%d] %s", id, reminder.Title)
+		} else {
+			refs[i] = fmt.Sprintf("[%d]", id)
+		}
+	}
+	return strings.Join(refs, ", ")
+}
+
+func yesNo(value bool) string {
+	if value {
+		return i18n.T("yes")
+	}
+	return i18n.T("no")
+}
+
+func init() {
+	registerFormatFlag(showCmd)
+	rootCmd.AddCommand(showCmd)
+}
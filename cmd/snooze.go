@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"urgent-reminder/internal/display"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/service"
+	"urgent-reminder/internal/storage"
+	"urgent-reminder/internal/timeutil"
+)
+
+var snoozeCmd = &cobra.Command{
+	Use:   "snooze [id|title] [duration]",
+	Short: "Push a reminder's due date back",
+	Long: `Push a reminder's due date back by a duration such as 2h, 1d or 1w (default 1d).
+
+Whole days move the due date from today, or from the current due date if it is
+later, and keep the time of day. Shorter durations always count from now, even
+for reminders due later, and set a due time.`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		duration := timeutil.Day
+		if len(args) == 2 {
+			var err error
+			duration, err = timeutil.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+		}
+
+		store, err := storage.NewJSONStore()
+		if err != nil {
+			return i18n.Errorf("failed to initialize storage: %w", err)
+		}
+
+		reminderService := service.NewReminderService(store)
+		displayObj := display.NewDisplay(noColor)
+
+		reminder, err := resolveConfirmedReminder(reminderService, args[0], false)
+		if err != nil {
+			return i18n.Errorf("failed to get reminder: %w", err)
+		}
+
+		reminder, err = reminderService.SnoozeReminder(reminder.ID, duration)
+		if err != nil {
+			return i18n.Errorf("failed to snooze reminder: %w", err)
+		}
+
+		displayObj.PrintSuccess(i18n.T("Reminder [%d] %s snoozed until %s", reminder.ID, reminder.Title, i18n.FormatDateTime(reminder.DueDate, reminder.Time)))
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(snoozeCmd)
+}
@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"urgent-reminder/internal/display"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/service"
+	"urgent-reminder/internal/storage"
+)
+
+var subCompleteParent bool
+
+var subCmd = &cobra.Command{
+	Use:   "sub",
+	Short: "Manage the subtasks of a reminder",
+	Long:  `Add ordered subtasks to a reminder and tick them off one by one. Use 'show' to see the numbered subtasks.`,
+}
+
+var subAddCmd = &cobra.Command{
+	Use:   "add [id|title] <subtask>",
+	Short: "Add a subtask to a reminder",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := storage.NewJSONStore()
+		if err != nil {
+			return i18n.Errorf("failed to initialize storage: %w", err)
+		}
+
+		reminderService := service.NewReminderService(store)
+		displayObj := display.NewDisplay(noColor)
+
+		reminder, err := resolveConfirmedReminder(reminderService, args[0], false)
+		if err != nil {
+			return i18n.Errorf("failed to get reminder: %w", err)
+		}
+
+		title := strings.TrimSpace(strings.Join(args[1:], " "))
+		if title == "" {
+			return i18n.Errorf("subtask cannot be empty")
+		}
+
+		reminder, err = reminderService.AddSubtask(reminder.ID, title)
+		if err != nil {
+			return i18n.Errorf("failed to add subtask: %w", err)
+		}
+
+		displayObj.PrintSuccess(i18n.T("Subtask %d added to [%d] %s", len(reminder.Subtasks), reminder.ID, reminder.Title))
+		return nil
+	},
+}
+
+var subCheckCmd = &cobra.Command{
+	Use:   "check [id|title] <n>...",
+	Short: "Mark subtasks as done",
+	Long:  `Mark subtasks as done. With --complete-parent, checking the last open subtask also checks the reminder itself.`,
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setSubtasksDone(args, true)
+	},
+}
+
+var subUncheckCmd = &cobra.Command{
+	Use:   "uncheck [id|title] <n>...",
+	Short: "Mark subtasks as not done",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setSubtasksDone(args, false)
+	},
+}
+
+func setSubtasksDone(args []string, done bool) error {
+	store, err := storage.NewJSONStore()
+	if err != nil {
+		return i18n.Errorf("failed to initialize storage: %w", err)
+	}
+
+	reminderService := service.NewReminderService(store)
+	displayObj := display.NewDisplay(noColor)
+
+	reminder, err := resolveConfirmedReminder(reminderService, args[0], false)
+	if err != nil {
+		return i18n.Errorf("failed to get reminder: %w", err)
+	}
+
+	var numbers []int
+	for _, arg := range args[1:] {
+		number, err := strconv.Atoi(arg)
+		if err != nil {
+			return i18n.Errorf("invalid subtask number: %s", arg)
+		}
+		numbers = append(numbers, number)
+	}
+
+	for _, number := range numbers {
+		reminder, err = reminderService.SetSubtaskDone(reminder.ID, number, done)
+		if err != nil {
+			return i18n.Errorf("failed to update subtask: %w", err)
+		}
+	}
+
+	completed, total := reminder.SubtaskProgress()
+	displayObj.PrintSuccess(i18n.T("[%d] %s: %d/%d subtasks done", reminder.ID, reminder.Title, completed, total))
+
+	if !done || completed < total {
+		return nil
+	}
+
+	if !subCompleteParent {
+		displayObj.PrintInfo(i18n.T("All subtasks are done. Run 'urgent-reminder check %d' to complete the reminder.", reminder.ID))
+		return nil
+	}
+
+	return checkReminder(reminderService, displayObj, reminder)
+}
+
+func init() {
+	subCheckCmd.Flags().BoolVar(&subCompleteParent, "complete-parent", false, "Check the reminder once all of its subtasks are done")
+
+	subCmd.AddCommand(subAddCmd, subCheckCmd, subUncheckCmd)
+	rootCmd.AddCommand(subCmd)
+}
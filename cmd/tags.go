@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"urgent-reminder/internal/display"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/output"
+	"urgent-reminder/internal/service"
+	"urgent-reminder/internal/storage"
+)
+
+var tagsLabels labelFilter
+
+var tagsCmd = &cobra.Command{
+	Use:   "tags",
+	Short: "List tags with reminder counts",
+	Long:  `List every tag used by a reminder together with the number of reminders carrying it.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, err := selectedOutputFormat()
+		if err != nil {
+			return err
+		}
+
+		store, err := storage.NewJSONStore()
+		if err != nil {
+			return i18n.Errorf("failed to initialize storage: %w", err)
+		}
+
+		reminderService := service.NewReminderService(store)
+		displayObj := display.NewDisplay(noColor)
+
+		query := service.ReminderQuery{All: true, Blocked: service.AnyBlocked, IncludeWaiting: true}
+		if err := tagsLabels.apply(&query); err != nil {
+			return err
+		}
+
+		tagCounts, err := reminderService.TagCounts(query)
+		if err != nil {
+			return i18n.Errorf("failed to list tags: %w", err)
+		}
+
+		if format != output.FormatText {
+			views := make([]output.TagCount, len(tagCounts))
+			for i, tagCount := range tagCounts {
+				views[i] = output.TagCount{Tag: tagCount.Tag, Count: tagCount.Count}
+			}
+			return output.WriteList(os.Stdout, format, views)
+		}
+
+		if len(tagCounts) == 0 {
+			displayObj.PrintInfo(i18n.T("No tags found."))
+			return nil
+		}
+
+		for _, tagCount := range tagCounts {
+			fmt.Printf("#%-20s %d\n", tagCount.Tag, tagCount.Count)
+		}
+		return nil
+	},
+}
+
+func init() {
+	tagsLabels.register(tagsCmd)
+	rootCmd.AddCommand(tagsCmd)
+}
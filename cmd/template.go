@@ -0,0 +1,211 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"urgent-reminder/internal/config"
+	"urgent-reminder/internal/display"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/models"
+	"urgent-reminder/internal/output"
+	"urgent-reminder/internal/service"
+	"urgent-reminder/internal/storage"
+)
+
+var templateForce bool
+
+var templateCmd = &cobra.Command{
+	Use:   "template",
+	Short: "Manage reminder templates",
+	Long: `Manage named reminder templates stored in the config directory.
+
+Templates are JSON files whose text fields may contain placeholders such as
+{{.sprint}}, filled in by 'add --template <name> --var sprint=42'.`,
+}
+
+var templateSaveCmd = &cobra.Command{
+	Use:   "save [id|title] [name]",
+	Short: "Save an existing reminder as a template",
+	Args:  cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := storage.NewJSONStore()
+		if err != nil {
+			return i18n.Errorf("failed to initialize storage: %w", err)
+		}
+
+		templateStore, err := storage.NewTemplateStore()
+		if err != nil {
+			return i18n.Errorf("failed to initialize template storage: %w", err)
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			return err
+		}
+
+		reminderService := service.NewReminderService(store)
+		displayObj := display.NewDisplay(noColor)
+
+		reminder, err := resolveConfirmedReminder(reminderService, args[0], false)
+		if err != nil {
+			return i18n.Errorf("failed to get reminder: %w", err)
+		}
+
+		name := templateNameFromTitle(reminder.Title)
+		if len(args) == 2 {
+			name = args[1]
+		}
+
+		if !templateForce {
+			if _, err := templateStore.LoadTemplate(name); err == nil {
+				return i18n.Errorf("template %q already exists, use --force to overwrite it", name)
+			}
+		}
+
+		tmpl := models.NewTemplateFromReminder(name, reminder)
+		if err := checkTemplateFields(cfg, tmpl); err != nil {
+			return err
+		}
+		if err := templateStore.SaveTemplate(tmpl); err != nil {
+			return err
+		}
+
+		displayObj.PrintSuccess(i18n.T("Template %q saved from [%d] %s", name, reminder.ID, reminder.Title))
+		displayObj.PrintInfo(i18n.T("Edit %s/%s.json to add placeholders such as {{.sprint}}.", templateStore.GetDir(), name))
+		return nil
+	},
+}
+
+var templateListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List saved templates",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, err := selectedOutputFormat()
+		if err != nil {
+			return err
+		}
+
+		templateStore, err := storage.NewTemplateStore()
+		if err != nil {
+			return i18n.Errorf("failed to initialize template storage: %w", err)
+		}
+
+		displayObj := display.NewDisplay(noColor)
+
+		names, err := templateStore.ListTemplates()
+		if err != nil {
+			return err
+		}
+
+		if format != output.FormatText {
+			views := []output.Template{}
+			for _, name := range names {
+				tmpl, err := templateStore.LoadTemplate(name)
+				if err != nil {
+					displayObj.PrintError(err.Error())
+					continue
+				}
+				views = append(views, output.Template{Name: name, Title: tmpl.Title})
+			}
+			return output.WriteList(os.Stdout, format, views)
+		}
+
+		if len(names) == 0 {
+			displayObj.PrintInfo(i18n.T("No templates found."))
+			return nil
+		}
+
+		for _, name := range names {
+			tmpl, err := templateStore.LoadTemplate(name)
+			if err != nil {
+				displayObj.PrintError(err.Error())
+				continue
+			}
+			fmt.Printf("%-20s %s\n", name, tmpl.Title)
+		}
+		return nil
+	},
+}
+
+var templateShowCmd = &cobra.Command{
+	Use:   "show [name]",
+	Short: "Show a saved template",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		templateStore, err := storage.NewTemplateStore()
+		if err != nil {
+			return i18n.Errorf("failed to initialize template storage: %w", err)
+		}
+
+		tmpl, err := templateStore.LoadTemplate(args[0])
+		if err != nil {
+			return err
+		}
+
+		data, err := json.MarshalIndent(tmpl, "", "  ")
+		if err != nil {
+			return i18n.Errorf("failed to marshal template: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	},
+}
+
+var templateDeleteCmd = &cobra.Command{
+	Use:   "delete [name]",
+	Short: "Delete a saved template",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		templateStore, err := storage.NewTemplateStore()
+		if err != nil {
+			return i18n.Errorf("failed to initialize template storage: %w", err)
+		}
+
+		displayObj := display.NewDisplay(noColor)
+
+		if err := templateStore.DeleteTemplate(args[0]); err != nil {
+			return err
+		}
+
+		displayObj.PrintSuccess(i18n.T("Template %q deleted", args[0]))
+		return nil
+	},
+}
+
+func checkTemplateFields(cfg *config.Config, tmpl *models.Template) error {
+	names := make([]string, 0, len(tmpl.Fields))
+	for name := range tmpl.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if _, ok := cfg.Field(strings.ToLower(strings.TrimSpace(name))); !ok {
+			return i18n.Errorf("template %q: field %q is not declared in the config file", tmpl.Name, name)
+		}
+	}
+	return nil
+}
+
+var nonSlugChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+func templateNameFromTitle(title string) string {
+	name := strings.Trim(nonSlugChars.ReplaceAllString(strings.ToLower(title), "-"), "-")
+	if name == "" {
+		return "template"
+	}
+	return name
+}
+
+func init() {
+	templateSaveCmd.Flags().BoolVar(&templateForce, "force", false, "Overwrite an existing template with the same name")
+
+	templateCmd.AddCommand(templateSaveCmd, templateListCmd, templateShowCmd, templateDeleteCmd)
+	rootCmd.AddCommand(templateCmd)
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"urgent-reminder/internal/config"
+	"urgent-reminder/internal/display"
+	"urgent-reminder/internal/i18n"
+)
+
+var themeName string
+
+func applyTheme(cfg *config.Config) {
+	if err := loadTheme(cfg); err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("Warning: %v, using the default theme", err))
+	}
+}
+
+func loadTheme(cfg *config.Config) error {
+	name := themeName
+	if name == "" {
+		name = os.Getenv("URGENT_REMINDER_THEME")
+	}
+	if name == "" {
+		name = cfg.Theme
+	}
+	if name == "" {
+		return nil
+	}
+
+	configDir, err := config.Dir()
+	if err != nil {
+		return err
+	}
+	theme, err := display.LoadTheme(name, configDir)
+	if err != nil {
+		return err
+	}
+	display.SetTheme(theme)
+	return nil
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&themeName, "theme", "", "Color theme: default, minimal, high-contrast, solarized or a theme file name")
+}
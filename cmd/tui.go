@@ -0,0 +1,633 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"github.com/spf13/cobra"
+	"urgent-reminder/internal/config"
+	"urgent-reminder/internal/display"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/models"
+	"urgent-reminder/internal/service"
+	"urgent-reminder/internal/storage"
+	"urgent-reminder/internal/timeutil"
+)
+
+var tuiCmd = &cobra.Command{
+	Use:   "tui",
+	Short: "Browse and manage reminders in a full-screen interface",
+	Long: `Open a full-screen view of all reminders grouped into overdue, today and
+upcoming, with a detail pane for the selected reminder.
+
+Keys: j/k or arrows to move, c check, s snooze, e edit, d delete, a add,
+/ filter, esc clear filter, r reload, q quit.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := storage.NewJSONStore()
+		if err != nil {
+			return i18n.Errorf("failed to initialize storage: %w", err)
+		}
+
+		if !display.ColorEnabled(noColor) {
+			lipgloss.SetColorProfile(termenv.Ascii)
+		}
+
+		model := newTUIModel(service.NewReminderService(store))
+		model.reload()
+
+		if _, err := tea.NewProgram(model, tea.WithAltScreen()).Run(); err != nil {
+			return i18n.Errorf("failed to run tui: %w", err)
+		}
+		return nil
+	},
+}
+
+type tuiMode int
+
+const (
+	tuiBrowse tuiMode = iota
+	tuiFilter
+	tuiSnooze
+	tuiAddTitle
+	tuiAddDate
+	tuiConfirmDelete
+)
+
+const (
+	groupOverdue  = "overdue"
+	groupToday    = "today"
+	groupUpcoming = "upcoming"
+)
+
+var (
+	tuiGroupStyles = map[string]lipgloss.Style{
+		groupOverdue:  lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("1")),
+		groupToday:    lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("3")),
+		groupUpcoming: lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("6")),
+	}
+	tuiSelectedStyle = lipgloss.NewStyle().Reverse(true)
+	tuiPaneStyle     = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 1)
+	tuiLabelStyle    = lipgloss.NewStyle().Bold(true)
+	tuiMutedStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+	tuiErrorStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("1"))
+)
+
+type tuiItem struct {
+	reminder *models.Reminder
+	group    string
+}
+
+type tuiModel struct {
+	service  *service.ReminderService
+	items    []tuiItem
+	cursor   int
+	filter   string
+	mode     tuiMode
+	input    textinput.Model
+	addTitle string
+	status   string
+	err      error
+	width    int
+	height   int
+}
+
+type tuiEditDoneMsg struct {
+	id       int
+	path     string
+	original string
+	err      error
+}
+
+func newTUIModel(reminderService *service.ReminderService) *tuiModel {
+	input := textinput.New()
+	input.CharLimit = 200
+	return &tuiModel{service: reminderService, input: input}
+}
+
+func (m *tuiModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m *tuiModel) reload() {
+	selectedID := 0
+	if reminder := m.selected(); reminder != nil {
+		selectedID = reminder.ID
+	}
+
+	query := service.ReminderQuery{All: true, Blocked: service.AnyBlocked, SortBy: service.SortByDue}
+
+	var reminders []*models.Reminder
+	if m.filter == "" {
+		var err error
+		reminders, err = m.service.QueryReminders(query)
+		if err != nil {
+			m.err = err
+			return
+		}
+	} else {
+		results, err := m.service.SearchReminders(m.filter, service.SearchSubstring, query)
+		if err != nil {
+			m.err = err
+			return
+		}
+		for _, result := range results {
+			reminders = append(reminders, result.Reminder)
+		}
+		service.SortReminders(reminders, service.SortByDue)
+	}
+
+	groups := map[string][]*models.Reminder{}
+	for _, reminder := range reminders {
+		groups[tuiGroup(reminder)] = append(groups[tuiGroup(reminder)], reminder)
+	}
+
+	m.items = nil
+	for _, group := range []string{groupOverdue, groupToday, groupUpcoming} {
+		for _, reminder := range groups[group] {
+			m.items = append(m.items, tuiItem{reminder: reminder, group: group})
+		}
+	}
+
+	m.cursor = 0
+	for i, item := range m.items {
+		if item.reminder.ID == selectedID {
+			m.cursor = i
+		}
+	}
+}
+
+func tuiGroup(reminder *models.Reminder) string {
+	now := time.Now()
+	due := reminder.DueDateTime()
+	switch {
+	case reminder.IsOverdue():
+		return groupOverdue
+	case due.Year() == now.Year() && due.YearDay() == now.YearDay():
+		return groupToday
+	default:
+		return groupUpcoming
+	}
+}
+
+func tuiGroupLabel(group string) string {
+	switch group {
+	case groupOverdue:
+		return i18n.T("Overdue")
+	case groupToday:
+		return i18n.T("Today")
+	default:
+		return i18n.T("Upcoming")
+	}
+}
+
+func (m *tuiModel) selected() *models.Reminder {
+	if m.cursor < 0 || m.cursor >= len(m.items) {
+		return nil
+	}
+	return m.items[m.cursor].reminder
+}
+
+func (m *tuiModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		return m, nil
+	case tuiEditDoneMsg:
+		m.finishEdit(msg)
+		return m, nil
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+		if m.mode == tuiBrowse {
+			return m.updateBrowse(msg)
+		}
+		return m.updateInput(msg)
+	}
+	return m, nil
+}
+
+func (m *tuiModel) updateBrowse(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	m.status, m.err = "", nil
+	reminder := m.selected()
+
+	switch msg.String() {
+	case "q":
+		return m, tea.Quit
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(m.items)-1 {
+			m.cursor++
+		}
+	case "home", "g":
+		m.cursor = 0
+	case "end", "G":
+		m.cursor = len(m.items) - 1
+	case "r":
+		m.reload()
+	case "/":
+		return m, m.startInput(tuiFilter, i18n.T("Filter: "), m.filter, i18n.T("title or notes"))
+	case "esc":
+		m.filter = ""
+		m.reload()
+	case "a":
+		return m, m.startInput(tuiAddTitle, i18n.T("Title: "), "", "")
+	case "c":
+		if reminder != nil {
+			m.check(reminder)
+		}
+	case "s":
+		if reminder != nil {
+			return m, m.startInput(tuiSnooze, i18n.T("Snooze for: "), "1d", "")
+		}
+	case "d":
+		if reminder != nil {
+			m.mode = tuiConfirmDelete
+		}
+	case "e":
+		if reminder != nil {
+			return m, m.startEdit(reminder)
+		}
+	}
+	return m, nil
+}
+
+func (m *tuiModel) startInput(mode tuiMode, prompt, value, placeholder string) tea.Cmd {
+	m.mode = mode
+	m.input.Prompt = prompt
+	m.input.Placeholder = placeholder
+	m.input.SetValue(value)
+	m.input.CursorEnd()
+	return m.input.Focus()
+}
+
+func (m *tuiModel) updateInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.mode == tuiConfirmDelete {
+		if msg.String() == "y" || msg.String() == "Y" {
+			m.delete(m.selected())
+		} else {
+			m.status = i18n.T("Delete cancelled.")
+		}
+		m.mode = tuiBrowse
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "esc":
+		if m.mode == tuiFilter {
+			m.filter = ""
+			m.reload()
+		}
+		m.mode = tuiBrowse
+		m.input.Blur()
+		return m, nil
+	case "enter":
+		return m, m.submitInput(strings.TrimSpace(m.input.Value()))
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	if m.mode == tuiFilter {
+		m.filter = strings.TrimSpace(m.input.Value())
+		m.reload()
+	}
+	return m, cmd
+}
+
+func (m *tuiModel) submitInput(value string) tea.Cmd {
+	mode := m.mode
+	m.mode = tuiBrowse
+	m.input.Blur()
+
+	switch mode {
+	case tuiFilter:
+		m.filter = value
+		m.reload()
+	case tuiSnooze:
+		m.snooze(m.selected(), value)
+	case tuiAddTitle:
+		if value == "" {
+			m.status = i18n.T("Add cancelled.")
+			return nil
+		}
+		m.addTitle = value
+		return m.startInput(tuiAddDate, i18n.T("Due date: "), i18n.FormatDate(time.Now()), i18n.DateHint())
+	case tuiAddDate:
+		m.add(m.addTitle, value)
+	}
+	return nil
+}
+
+func (m *tuiModel) check(reminder *models.Reminder) {
+	if err := m.service.CheckReminder(reminder.ID); err != nil {
+		m.err = err
+		return
+	}
+
+	if reminder.IsRecurrent {
+		updated, err := m.service.GetReminder(reminder.ID)
+		if err == nil {
+			m.status = "✓ " + i18n.T("[%d] %s, next due %s", reminder.ID, reminder.Title, i18n.FormatDate(updated.DueDate))
+		}
+	} else {
+		m.status = "✓ " + i18n.T("[%d] %s completed", reminder.ID, reminder.Title)
+	}
+	m.reload()
+}
+
+func (m *tuiModel) snooze(reminder *models.Reminder, value string) {
+	if reminder == nil {
+		return
+	}
+
+	duration, err := timeutil.ParseDuration(value)
+	if err != nil {
+		m.err = err
+		return
+	}
+
+	updated, err := m.service.SnoozeReminder(reminder.ID, duration)
+	if err != nil {
+		m.err = err
+		return
+	}
+	m.status = "✓ " + i18n.T("[%d] %s snoozed until %s", updated.ID, updated.Title, i18n.FormatDateTime(updated.DueDate, updated.Time))
+	m.reload()
+}
+
+func (m *tuiModel) delete(reminder *models.Reminder) {
+	if reminder == nil {
+		return
+	}
+	if err := m.service.DeleteReminder(reminder.ID); err != nil {
+		m.err = err
+		return
+	}
+	m.status = "✓ " + i18n.T("[%d] %s deleted", reminder.ID, reminder.Title)
+	m.reload()
+}
+
+func (m *tuiModel) add(title, date string) {
+	if date == "" {
+		date = time.Now().Format("2006-01-02")
+	}
+	dueDate, err := i18n.ParseDate(date)
+	if err != nil {
+		m.err = err
+		return
+	}
+
+	nextID, err := m.service.GetNextID()
+	if err != nil {
+		m.err = err
+		return
+	}
+
+	reminder := models.NewReminder(nextID, title, dueDate)
+	if err := m.service.AddReminder(reminder); err != nil {
+		m.err = err
+		return
+	}
+	m.status = "✓ " + i18n.T("[%d] %s added, press e to fill in the details", reminder.ID, reminder.Title)
+	m.reload()
+	for i, item := range m.items {
+		if item.reminder.ID == reminder.ID {
+			m.cursor = i
+		}
+	}
+}
+
+func (m *tuiModel) startEdit(reminder *models.Reminder) tea.Cmd {
+	cfg, err := config.Load()
+	if err != nil {
+		m.err = err
+		return nil
+	}
+
+	original := renderEditDocument(reminder, cfg)
+	path, err := writeEditFile(original)
+	if err != nil {
+		m.err = err
+		return nil
+	}
+
+	return tea.ExecProcess(editorCommand(path), func(err error) tea.Msg {
+		return tuiEditDoneMsg{id: reminder.ID, path: path, original: original, err: err}
+	})
+}
+
+func (m *tuiModel) finishEdit(msg tuiEditDoneMsg) {
+	defer os.Remove(msg.path)
+
+	if msg.err != nil {
+		m.err = i18n.Errorf("editor %q failed: %w", editorName(), msg.err)
+		return
+	}
+
+	edited, err := readEditFile(msg.path)
+	if err != nil {
+		m.err = err
+		return
+	}
+	if edited == msg.original {
+		m.status = i18n.T("No changes made.")
+		return
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		m.err = err
+		return
+	}
+	reminder, err := m.service.GetReminder(msg.id)
+	if err != nil {
+		m.err = err
+		return
+	}
+	if err := applyEditDocument(reminder, edited, cfg); err != nil {
+		m.err = i18n.Errorf("invalid reminder: %w", err)
+		return
+	}
+	if err := m.service.UpdateReminder(reminder); err != nil {
+		m.err = err
+		return
+	}
+
+	m.status = "✓ " + i18n.T("Reminder [%d] updated", reminder.ID)
+	m.reload()
+}
+
+func (m *tuiModel) View() string {
+	width, height := m.width, m.height
+	if width == 0 {
+		width, height = 100, 30
+	}
+
+	listWidth := width * 45 / 100
+	detailWidth := width - listWidth
+	paneHeight := height - 4
+	if paneHeight < 3 {
+		paneHeight = 3
+	}
+
+	frame := tuiPaneStyle.GetHorizontalFrameSize()
+	list := tuiPaneStyle.Width(listWidth - frame).Height(paneHeight).Render(m.listView(listWidth-frame-2, paneHeight))
+	detail := tuiPaneStyle.Width(detailWidth - frame).Height(paneHeight).Render(m.detailView(detailWidth - frame - 2))
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		lipgloss.JoinHorizontal(lipgloss.Top, list, detail),
+		m.footerView(),
+	)
+}
+
+func (m *tuiModel) listView(width, height int) string {
+	if len(m.items) == 0 {
+		if m.filter != "" {
+			return tuiMutedStyle.Render(i18n.T("No reminders match %q.", m.filter))
+		}
+		return tuiMutedStyle.Render(i18n.T("No reminders. Press a to add one."))
+	}
+
+	if width < 24 {
+		width = 24
+	}
+
+	var lines []string
+	selectedLine := 0
+	group := ""
+	for i, item := range m.items {
+		if item.group != group {
+			group = item.group
+			if len(lines) > 0 {
+				lines = append(lines, "")
+			}
+			lines = append(lines, tuiGroupStyles[group].Render(tuiGroupLabel(group)))
+		}
+
+		line := truncateText(fmt.Sprintf("[%d] %s", item.reminder.ID, item.reminder.Title), width-13)
+		line = fmt.Sprintf("%-*s %12s", width-13, line, tuiShortDue(item.reminder))
+		if i == m.cursor {
+			selectedLine = len(lines)
+			line = tuiSelectedStyle.Render(line)
+		}
+		lines = append(lines, line)
+	}
+
+	start := 0
+	if selectedLine >= height {
+		start = selectedLine - height + 1
+	}
+	end := start + height
+	if end > len(lines) {
+		end = len(lines)
+	}
+	return strings.Join(lines[start:end], "\n")
+}
+
+func tuiShortDue(reminder *models.Reminder) string {
+	if reminder.Time != "" {
+		return i18n.FormatDayMonth(reminder.DueDate) + " " + reminder.Time
+	}
+	return i18n.FormatDayMonth(reminder.DueDate)
+}
+
+func (m *tuiModel) detailView(width int) string {
+	reminder := m.selected()
+	if reminder == nil {
+		return ""
+	}
+
+	now := time.Now()
+	var b strings.Builder
+	field := func(label, value string) {
+		if value != "" {
+			fmt.Fprintf(&b, "%s %s\n", tuiLabelStyle.Render(fmt.Sprintf("%-11s", label+":")), value)
+		}
+	}
+
+	b.WriteString(tuiLabelStyle.Render(truncateText(reminder.Title, width)) + "\n\n")
+	field("ID", fmt.Sprintf("%d", reminder.ID))
+	field(i18n.T("Due"), i18n.FormatDateTime(reminder.DueDate, reminder.Time)+" ("+reminder.RelativeDue(now)+")")
+	field(i18n.T("Status"), i18n.T(reminder.DueStatus()))
+	field(i18n.T("Priority"), i18n.T(string(reminder.EffectivePriority())))
+	field(i18n.T("Repeats"), reminder.RecurrenceDescription())
+	if reminder.IsRecurrent {
+		var next []string
+		for _, occurrence := range m.service.UpcomingOccurrences(reminder, now, 3) {
+			next = append(next, i18n.WeekdayShort(occurrence.Weekday())+" "+i18n.FormatDayMonth(occurrence))
+		}
+		field(i18n.T("Next"), strings.Join(next, ", "))
+	}
+	field(i18n.T("Project"), reminder.Project)
+	field(i18n.T("Tags"), reminder.FormatTags())
+	if done, total := reminder.SubtaskProgress(); total > 0 {
+		field(i18n.T("Subtasks"), i18n.T("%d/%d done", done, total))
+	}
+	if len(reminder.BlockedBy) > 0 {
+		field(i18n.T("Blocked by"), formatReminderRefs(m.service, reminder.BlockedBy))
+	}
+	for _, name := range reminder.FieldNames() {
+		field(name, reminder.Fields[name])
+	}
+	if len(reminder.Links) > 0 {
+		field(i18n.T("Links"), strings.Join(reminder.Links, " "))
+	}
+
+	if reminder.Notes != "" {
+		b.WriteString("\n")
+		for _, line := range strings.Split(reminder.Notes, "\n") {
+			b.WriteString(truncateText(line, width) + "\n")
+		}
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
+
+func (m *tuiModel) footerView() string {
+	switch m.mode {
+	case tuiConfirmDelete:
+		if reminder := m.selected(); reminder != nil {
+			return tuiErrorStyle.Render(i18n.T("Delete [%d] %s? (y/N)", reminder.ID, reminder.Title))
+		}
+	case tuiFilter, tuiSnooze, tuiAddTitle, tuiAddDate:
+		return m.input.View() + tuiMutedStyle.Render("  "+i18n.T("enter to confirm, esc to cancel"))
+	}
+
+	if m.err != nil {
+		return tuiErrorStyle.Render(i18n.T("Error: %s", m.err.Error()))
+	}
+	if m.status != "" {
+		return m.status
+	}
+
+	help := i18n.T("j/k move · c check · s snooze · e edit · d delete · a add · / filter · r reload · q quit")
+	if m.filter != "" {
+		help = i18n.T("filter: %q (esc to clear) · ", m.filter) + help
+	}
+	return tuiMutedStyle.Render(help)
+}
+
+func truncateText(text string, width int) string {
+	runes := []rune(text)
+	if width <= 0 || len(runes) <= width {
+		return text
+	}
+	if width == 1 {
+		return "…"
+	}
+	return string(runes[:width-1]) + "…"
+}
+
+func init() {
+	rootCmd.AddCommand(tuiCmd)
+}
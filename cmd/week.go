@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"urgent-reminder/internal/display"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/models"
+	"urgent-reminder/internal/output"
+	"urgent-reminder/internal/service"
+	"urgent-reminder/internal/storage"
+	"urgent-reminder/internal/timeutil"
+)
+
+var weekLabels labelFilter
+
+var weekCmd = &cobra.Command{
+	Use:   "week [date]",
+	Short: "Show an agenda for the current week",
+	Long: `Show each day of the week from Monday to Sunday with its reminders,
+all-day reminders first and timed reminders in order.
+
+Pass a date (YYYY-MM-DD) to show the week containing it. Recurrent reminders
+are expanded into their future occurrences.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, err := selectedOutputFormat()
+		if err != nil {
+			return err
+		}
+
+		query := service.ReminderQuery{}
+		if err := weekLabels.apply(&query); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+		if len(args) == 1 {
+			day, err = timeutil.ParseDate(args[0])
+			if err != nil {
+				return err
+			}
+		}
+
+		store, err := storage.NewJSONStore()
+		if err != nil {
+			return i18n.Errorf("failed to initialize storage: %w", err)
+		}
+
+		reminderService := service.NewReminderService(store)
+		displayObj := display.NewDisplay(noColor)
+
+		monday := day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+		sunday := monday.AddDate(0, 0, 6)
+		occurrences, err := reminderService.OccurrencesBetween(monday, sunday, query)
+		if err != nil {
+			return i18n.Errorf("failed to load reminders: %w", err)
+		}
+		if format != output.FormatText {
+			return writeOccurrences(reminderService, format, occurrences)
+		}
+
+		byDay := map[string][]*models.Reminder{}
+		for _, occurrence := range occurrences {
+			key := occurrence.Date.Format("2006-01-02")
+			byDay[key] = append(byDay[key], occurrence.Reminder)
+		}
+
+		today := now.Format("2006-01-02")
+		for i := 0; i < 7; i++ {
+			date := monday.AddDate(0, 0, i)
+			key := date.Format("2006-01-02")
+			displayObj.PrintAgendaDay(date, key == today, byDay[key])
+		}
+
+		displayObj.PrintEmpty()
+		displayObj.PrintInfo(i18n.T("Total: %d reminder(s) this week", len(occurrences)))
+		return nil
+	},
+}
+
+func init() {
+	weekLabels.register(weekCmd)
+	rootCmd.AddCommand(weekCmd)
+}
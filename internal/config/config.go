@@ -0,0 +1,107 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"urgent-reminder/internal/i18n"
+)
+
+const (
+	appName    = "urgent-reminder"
+	configFile = "config.json"
+)
+
+type Config struct {
+	Tiers   []Tier            `json:"tiers,omitempty"`
+	Fields  []FieldDef        `json:"fields,omitempty"`
+	Formats map[string]string `json:"formats,omitempty"`
+	Prompt  string            `json:"prompt,omitempty"`
+	Theme   string            `json:"theme,omitempty"`
+	Locale  string            `json:"locale,omitempty"`
+}
+
+func Default() *Config {
+	cfg := &Config{}
+	cfg.applyDefaults()
+	return cfg
+}
+
+func Dir() (string, error) {
+	configHome := os.Getenv("XDG_CONFIG_HOME")
+	if configHome == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", i18n.Errorf("failed to get home directory: %w", err)
+		}
+		configHome = filepath.Join(homeDir, ".config")
+	}
+	return filepath.Join(configHome, appName), nil
+}
+
+func Path() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, configFile), nil
+}
+
+func Load() (*Config, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return Default(), nil
+		}
+		return nil, i18n.Errorf("failed to read config file: %w", err)
+	}
+
+	cfg := &Config{}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, i18n.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	cfg.applyDefaults()
+
+	if err := cfg.validate(); err != nil {
+		return nil, i18n.Errorf("invalid config file %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+func (c *Config) applyDefaults() {
+	if c.Tiers == nil {
+		c.Tiers = DefaultTiers()
+	}
+}
+
+func (c *Config) validate() error {
+	for _, tier := range c.Tiers {
+		if err := tier.validate(); err != nil {
+			return err
+		}
+	}
+	for _, field := range c.Fields {
+		if err := field.validate(); err != nil {
+			return err
+		}
+	}
+	for name, format := range c.Formats {
+		if strings.TrimSpace(format) == "" {
+			return i18n.Errorf("format %q cannot be empty", name)
+		}
+	}
+	return nil
+}
+
+func (c *Config) Format(name string) (string, bool) {
+	format, ok := c.Formats[name]
+	return format, ok
+}
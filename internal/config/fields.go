@@ -0,0 +1,103 @@
+package config
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"urgent-reminder/internal/i18n"
+)
+
+type FieldType string
+
+const (
+	FieldString FieldType = "string"
+	FieldInt    FieldType = "int"
+	FieldDate   FieldType = "date"
+	FieldEnum   FieldType = "enum"
+)
+
+var fieldNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+type FieldDef struct {
+	Name   string    `json:"name"`
+	Type   FieldType `json:"type,omitempty"`
+	Values []string  `json:"values,omitempty"`
+}
+
+func ValidateFieldName(name string) error {
+	if !fieldNamePattern.MatchString(name) {
+		return i18n.Errorf("invalid field name %q, use lowercase letters, digits, '-' and '_'", name)
+	}
+	return nil
+}
+
+func (f FieldDef) Normalize(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", nil
+	}
+
+	switch f.Type {
+	case FieldInt:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return "", i18n.Errorf("field %q must be an integer", f.Name)
+		}
+		return strconv.Itoa(n), nil
+	case FieldDate:
+		if _, err := time.Parse("2006-01-02", value); err != nil {
+			return "", i18n.Errorf("field %q must be a date (YYYY-MM-DD)", f.Name)
+		}
+		return value, nil
+	case FieldEnum:
+		for _, allowed := range f.Values {
+			if strings.EqualFold(allowed, value) {
+				return allowed, nil
+			}
+		}
+		return "", i18n.Errorf("field %q must be one of: %s", f.Name, strings.Join(f.Values, ", "))
+	default:
+		return value, nil
+	}
+}
+
+func (f FieldDef) validate() error {
+	if err := ValidateFieldName(f.Name); err != nil {
+		return err
+	}
+	switch f.Type {
+	case "", FieldString, FieldInt, FieldDate:
+		return nil
+	case FieldEnum:
+		if len(f.Values) == 0 {
+			return i18n.Errorf("enum field %q needs a list of values", f.Name)
+		}
+		return nil
+	default:
+		return i18n.Errorf("field %q has unknown type %q, use string, int, date or enum", f.Name, f.Type)
+	}
+}
+
+func (c *Config) Field(name string) (FieldDef, bool) {
+	for _, field := range c.Fields {
+		if field.Name == name {
+			return field, true
+		}
+	}
+	return FieldDef{}, false
+}
+
+func (c *Config) NormalizeField(name, value string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if err := ValidateFieldName(name); err != nil {
+		return "", err
+	}
+
+	field, ok := c.Field(name)
+	if !ok {
+		return strings.TrimSpace(value), nil
+	}
+	return field.Normalize(value)
+}
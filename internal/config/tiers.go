@@ -0,0 +1,91 @@
+package config
+
+import (
+	"sort"
+	"time"
+
+	"urgent-reminder/internal/display"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/models"
+	"urgent-reminder/internal/timeutil"
+)
+
+type Tier struct {
+	Name         string `json:"name"`
+	OverdueAfter string `json:"overdue_after,omitempty"`
+	Color        string `json:"color,omitempty"`
+	Marker       string `json:"marker,omitempty"`
+	BannerColor  string `json:"banner_color,omitempty"`
+	Loud         bool   `json:"loud,omitempty"`
+	Command      string `json:"command,omitempty"`
+}
+
+func DefaultTiers() []Tier {
+	return []Tier{
+		{Name: "due", OverdueAfter: "0", Color: "yellow", Marker: "!"},
+		{Name: "overdue", OverdueAfter: "1d", Color: "red", Marker: "!!", BannerColor: "red"},
+		{Name: "critical", OverdueAfter: "7d", Color: "bold red", Marker: "!!!", BannerColor: "bold red", Loud: true},
+	}
+}
+
+func (t Tier) Threshold() time.Duration {
+	if t.OverdueAfter == "" || t.OverdueAfter == "0" {
+		return 0
+	}
+	threshold, _ := timeutil.ParseDuration(t.OverdueAfter)
+	return threshold
+}
+
+func (t Tier) validate() error {
+	if t.Name == "" {
+		return i18n.Errorf("every tier needs a name")
+	}
+	for _, spec := range []string{t.Color, t.BannerColor} {
+		if err := display.ValidateColor(spec); err != nil {
+			return i18n.Errorf("tier %q: %w", t.Name, err)
+		}
+	}
+	if t.OverdueAfter == "" || t.OverdueAfter == "0" {
+		return nil
+	}
+	if _, err := timeutil.ParseDuration(t.OverdueAfter); err != nil {
+		return i18n.Errorf("tier %q: %w", t.Name, err)
+	}
+	return nil
+}
+
+func (c *Config) TierFor(reminder *models.Reminder, now time.Time) (Tier, bool) {
+	if !reminder.IsDue() {
+		return Tier{}, false
+	}
+
+	overdueBy := now.Sub(reminder.DueDateTime())
+
+	var match Tier
+	found := false
+	for _, tier := range c.sortedTiers() {
+		if overdueBy >= tier.Threshold() {
+			match = tier
+			found = true
+		}
+	}
+	return match, found
+}
+
+func (c *Config) TierRank(name string) int {
+	for i, tier := range c.sortedTiers() {
+		if tier.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (c *Config) sortedTiers() []Tier {
+	tiers := make([]Tier, len(c.Tiers))
+	copy(tiers, c.Tiers)
+	sort.SliceStable(tiers, func(i, j int) bool {
+		return tiers[i].Threshold() < tiers[j].Threshold()
+	})
+	return tiers
+}
@@ -3,10 +3,14 @@ package display
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/common-nighthawk/go-figure"
 	"github.com/fatih/color"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/models"
 )
 
 type Display struct {
@@ -14,62 +18,76 @@ type Display struct {
 }
 
 func NewDisplay(noColor bool) *Display {
-	if noColor {
-		color.NoColor = true
-	}
-	return &Display{noColor: noColor}
+	color.NoColor = !ColorEnabled(noColor)
+	return &Display{noColor: color.NoColor}
 }
 
-func (d *Display) PrintBanner() {
-	myFigure := figure.NewFigure("URGENT", "", true)
-	myFigure.Print()
-	figure2 := figure.NewFigure("REMINDERS", "", true)
-	figure2.Print()
+func (d *Display) PrintBanner() bool {
+	return d.PrintColoredBanner("")
 }
 
-func (d *Display) PrintSeparator() {
-	width := 60
-	separator := strings.Repeat("=", width)
-	fmt.Println(separator)
-}
+func (d *Display) PrintColoredBanner(colorSpec string) bool {
+	if !activeTheme.HasBanner() || !IsTerminal() {
+		return false
+	}
+	if colorSpec == "" {
+		colorSpec = activeTheme.Banner.Color
+	}
 
-func (d *Display) PrintReminder(description string, daysRemaining int, alertEnabled bool) {
-	var status string
-	var dayText string
+	var lines []string
+	width := TerminalWidth()
+	for _, text := range activeTheme.Banner.Lines {
+		for _, line := range figure.NewFigure(text, activeTheme.Banner.Font, false).Slicify() {
+			if utf8.RuneCountInString(line) > width {
+				return false
+			}
+			lines = append(lines, line)
+		}
+	}
 
-	if alertEnabled {
-		status = color.New(color.FgCyan, color.Bold).Sprint("[ACTIVE]")
-	} else {
-		status = color.New(color.FgHiBlack).Sprint("[DONE]")
+	c := themeColor(colorSpec)
+	for _, line := range lines {
+		if colorSpec == "" {
+			fmt.Println(line)
+		} else {
+			fmt.Println(c.Sprint(line))
+		}
 	}
+	return true
+}
 
-	if daysRemaining < 0 {
-		dayText = color.New(color.FgRed).SprintFunc()(fmt.Sprintf("Overdue by %d days", -daysRemaining))
-	} else if daysRemaining == 0 {
-		dayText = color.New(color.FgYellow).SprintFunc()("Due today!")
-	} else if daysRemaining == 1 {
-		dayText = color.New(color.FgYellow).SprintFunc()("Due in 1 day")
-	} else {
-		dayText = fmt.Sprintf("Due in %d days", daysRemaining)
+func (d *Display) PrintLoud(message string) {
+	if IsTerminal() {
+		fmt.Print("\a")
 	}
+	fmt.Println(themeColor(activeTheme.Colors.Loud).Sprintf(" %s ", message))
+}
 
-	fmt.Printf("%s %s -- %s\n", status, description, dayText)
+func (d *Display) PrintSeparator() {
+	width := activeTheme.Separator.Width
+	if termWidth := TerminalWidth(); termWidth < width {
+		width = termWidth
+	}
+	fmt.Println(strings.Repeat(activeTheme.Separator.Char, width))
 }
 
 func (d *Display) PrintSuccess(message string) {
-	fmt.Println(color.GreenString(message))
+	if glyph := activeTheme.Glyphs.Success; glyph != "" {
+		message = glyph + " " + message
+	}
+	fmt.Println(themeColor(activeTheme.Colors.Success).Sprint(message))
 }
 
 func (d *Display) PrintError(message string) {
-	fmt.Fprintln(os.Stderr, color.RedString(message))
+	fmt.Fprintln(os.Stderr, themeColor(activeTheme.Colors.Error).Sprint(message))
 }
 
 func (d *Display) PrintInfo(message string) {
-	fmt.Println(color.BlueString(message))
+	fmt.Println(themeColor(activeTheme.Colors.Info).Sprint(message))
 }
 
 func (d *Display) PrintWarning(message string) {
-	fmt.Println(color.YellowString(message))
+	fmt.Println(themeColor(activeTheme.Colors.Warning).Sprint(message))
 }
 
 func (d *Display) PrintEmpty() {
@@ -82,10 +100,214 @@ func (d *Display) PrintHeader(text string) {
 	d.PrintSeparator()
 }
 
-func (d *Display) PrintSimpleReminder(id int, title, date, time string) {
-	if time != "" {
-		fmt.Printf("[%d] %s -- %s -- %s\n", id, title, date, time)
-	} else {
-		fmt.Printf("[%d] %s -- %s\n", id, title, date)
+func (d *Display) PrintSimpleReminder(reminder *models.Reminder) {
+	fmt.Printf("%s%s\n", formatReminderLine(reminder), formatLabels(reminder))
+}
+
+func (d *Display) PrintStatusReminder(reminder *models.Reminder, when string) {
+	if when == "" {
+		when = i18n.T(reminder.DueStatus())
+	}
+
+	statusText := statusColor(reminder).Sprint(when)
+
+	fmt.Printf("%s (%s)%s\n", formatReminderLine(reminder), statusText, formatLabels(reminder))
+}
+
+func (d *Display) PrintTieredReminder(reminder *models.Reminder, marker, colorSpec, when string) {
+	var whenText string
+	if when != "" {
+		whenText = " " + statusColor(reminder).Sprintf("(%s)", when)
+	}
+
+	if marker == "" {
+		fmt.Printf("%s%s%s\n", formatReminderLine(reminder), whenText, formatLabels(reminder))
+		return
+	}
+
+	markerText := ParseColor(colorSpec).Sprintf("%-3s", marker)
+	fmt.Printf("%s %s%s%s\n", markerText, formatReminderLine(reminder), whenText, formatLabels(reminder))
+}
+
+func (d *Display) PrintUpcomingReminder(reminder *models.Reminder, countdown string) {
+	countdownText := themeColor(activeTheme.Colors.Countdown).Sprintf("(%s)", countdown)
+	fmt.Printf("%s %s%s\n", formatReminderLine(reminder), countdownText, formatLabels(reminder))
+}
+
+func (d *Display) PrintBlockedReminder(reminder *models.Reminder) {
+	blockers := make([]string, len(reminder.BlockedBy))
+	for i, id := range reminder.BlockedBy {
+		blockers[i] = fmt.Sprintf("[%d]", id)
+	}
+
+	blockedText := themeColor(activeTheme.Colors.Blocked).Sprint(i18n.T("(blocked by %s)", strings.Join(blockers, ", ")))
+	fmt.Printf("%s%s %s%s\n", glyphPrefix(activeTheme.Glyphs.Blocked, activeTheme.Colors.Blocked), reminderLine(reminder), blockedText, formatLabels(reminder))
+}
+
+func (d *Display) SubtaskMark(done bool) string {
+	if done {
+		return activeTheme.Glyphs.Done
+	}
+	return activeTheme.Glyphs.Pending
+}
+
+func formatReminderLine(reminder *models.Reminder) string {
+	var glyph string
+	switch reminder.DueStatus() {
+	case "overdue":
+		glyph = activeTheme.Glyphs.Overdue
+	case "due":
+		glyph = activeTheme.Glyphs.Due
+	default:
+		glyph = activeTheme.Glyphs.Upcoming
+	}
+	return glyphPrefix(glyph, statusColorSpec(reminder)) + reminderLine(reminder)
+}
+
+func reminderLine(reminder *models.Reminder) string {
+	title := priorityColor(reminder.EffectivePriority()).Sprint(reminder.Title)
+	if reminder.Time != "" {
+		return fmt.Sprintf("[%d] %s -- %s -- %s", reminder.ID, title, i18n.FormatDate(reminder.DueDate), reminder.FormatTime())
+	}
+	return fmt.Sprintf("[%d] %s -- %s", reminder.ID, title, i18n.FormatDate(reminder.DueDate))
+}
+
+func glyphPrefix(glyph, colorSpec string) string {
+	if glyph == "" {
+		return ""
+	}
+	return themeColor(colorSpec).Sprint(glyph) + " "
+}
+
+func statusColorSpec(reminder *models.Reminder) string {
+	switch reminder.DueStatus() {
+	case "overdue":
+		return activeTheme.Colors.Overdue
+	case "due":
+		return activeTheme.Colors.Due
+	default:
+		return activeTheme.Colors.Upcoming
+	}
+}
+
+func statusColor(reminder *models.Reminder) *color.Color {
+	return themeColor(statusColorSpec(reminder))
+}
+
+func priorityColor(priority models.Priority) *color.Color {
+	switch priority {
+	case models.PriorityLow:
+		return themeColor(activeTheme.Colors.PriorityLow)
+	case models.PriorityHigh:
+		return themeColor(activeTheme.Colors.PriorityHigh)
+	case models.PriorityCritical:
+		return themeColor(activeTheme.Colors.PriorityCritical)
+	default:
+		return themeColor(activeTheme.Colors.PriorityNormal)
+	}
+}
+
+func themeColor(spec string) *color.Color {
+	if strings.TrimSpace(spec) == "" {
+		return color.New(color.Reset)
+	}
+	return ParseColor(spec)
+}
+
+func formatLabels(reminder *models.Reminder) string {
+	var labels []string
+	if done, total := reminder.SubtaskProgress(); total > 0 {
+		labels = append(labels, fmt.Sprintf("[%d/%d]", done, total))
+	}
+	if reminder.Project != "" {
+		labels = append(labels, "@"+reminder.Project)
+	}
+	if len(reminder.Tags) > 0 {
+		labels = append(labels, reminder.FormatTags())
+	}
+	if len(labels) == 0 {
+		return ""
+	}
+	return " " + themeColor(activeTheme.Colors.Labels).Sprint(strings.Join(labels, " "))
+}
+
+func (d *Display) PrintField(label, value string) {
+	fmt.Printf("%s %s\n", themeColor(activeTheme.Colors.Label).Sprintf("%-14s", label+":"), value)
+}
+
+var colorAttributes = map[string]color.Attribute{
+	"bold":      color.Bold,
+	"faint":     color.Faint,
+	"italic":    color.Italic,
+	"underline": color.Underline,
+	"blink":     color.BlinkSlow,
+	"reverse":   color.ReverseVideo,
+	"black":     color.FgBlack,
+	"red":       color.FgRed,
+	"green":     color.FgGreen,
+	"yellow":    color.FgYellow,
+	"blue":      color.FgBlue,
+	"magenta":   color.FgMagenta,
+	"cyan":      color.FgCyan,
+	"white":     color.FgWhite,
+	"hiblack":   color.FgHiBlack,
+	"hired":     color.FgHiRed,
+	"higreen":   color.FgHiGreen,
+	"hiyellow":  color.FgHiYellow,
+	"hiblue":    color.FgHiBlue,
+	"himagenta": color.FgHiMagenta,
+	"hicyan":    color.FgHiCyan,
+	"hiwhite":   color.FgHiWhite,
+}
+
+func ParseColor(spec string) *color.Color {
+	c := color.New()
+	for _, word := range strings.Fields(strings.ToLower(spec)) {
+		if attributes, ok := colorWord(word); ok {
+			c.Add(attributes...)
+		}
+	}
+	return c
+}
+
+func ValidateColor(spec string) error {
+	for _, word := range strings.Fields(strings.ToLower(spec)) {
+		if _, ok := colorWord(word); !ok {
+			return i18n.Errorf("unknown color or attribute %q in %q", word, spec)
+		}
+	}
+	return nil
+}
+
+func colorWord(word string) ([]color.Attribute, bool) {
+	if attribute, ok := colorAttributes[word]; ok {
+		return []color.Attribute{attribute}, true
+	}
+	if rgb, ok := parseHexColor(word); ok {
+		return append([]color.Attribute{38, 2}, rgb...), true
+	}
+	if name, ok := strings.CutPrefix(word, "on-"); ok {
+		if attribute, ok := colorAttributes[name]; ok && attribute >= color.FgBlack {
+			return []color.Attribute{attribute + 10}, true
+		}
+		if rgb, ok := parseHexColor(name); ok {
+			return append([]color.Attribute{48, 2}, rgb...), true
+		}
+	}
+	return nil, false
+}
+
+func parseHexColor(word string) ([]color.Attribute, bool) {
+	if len(word) != 7 || word[0] != '#' {
+		return nil, false
+	}
+	value, err := strconv.ParseUint(word[1:], 16, 32)
+	if err != nil {
+		return nil, false
 	}
+	return []color.Attribute{
+		color.Attribute(value >> 16 & 0xff),
+		color.Attribute(value >> 8 & 0xff),
+		color.Attribute(value & 0xff),
+	}, true
 }
@@ -0,0 +1,80 @@
+package display
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/models"
+)
+
+const calendarCellWidth = 7
+
+func (d *Display) PrintMonth(year int, month time.Month, counts map[int]int, overdue map[int]bool, today time.Time) {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.Local)
+	daysInMonth := time.Date(year, month+1, 0, 0, 0, 0, 0, time.Local).Day()
+
+	title := i18n.FormatMonth(year, month)
+	width := calendarCellWidth * len(models.Weekdays)
+	fmt.Println(themeColor(activeTheme.Colors.Header).Sprint(strings.Repeat(" ", (width-utf8.RuneCountInString(title))/2) + title))
+
+	headers := make([]string, len(models.Weekdays))
+	for i, day := range models.Weekdays {
+		headers[i] = fmt.Sprintf("%-*s", calendarCellWidth, i18n.WeekdayShort(day))
+	}
+	fmt.Println(themeColor(activeTheme.Colors.Muted).Sprint(strings.TrimRight(strings.Join(headers, ""), " ")))
+
+	offset := (int(first.Weekday()) + 6) % 7
+	var line strings.Builder
+	line.WriteString(strings.Repeat(" ", offset*calendarCellWidth))
+
+	for day := 1; day <= daysInMonth; day++ {
+		cell := fmt.Sprintf("%2d", day)
+		if count := counts[day]; count > 0 {
+			cell += fmt.Sprintf("(%d)", count)
+		}
+		cell = fmt.Sprintf("%-*s", calendarCellWidth-1, cell)
+
+		var spec string
+		switch {
+		case overdue[day]:
+			spec = activeTheme.Colors.Overdue
+		case counts[day] > 0:
+			spec = activeTheme.Colors.Due
+		}
+		if today.Year() == year && today.Month() == month && today.Day() == day {
+			spec += " " + activeTheme.Colors.Today
+		}
+		line.WriteString(themeColor(spec).Sprint(cell) + " ")
+
+		if (offset+day)%7 == 0 || day == daysInMonth {
+			fmt.Println(strings.TrimRight(line.String(), " "))
+			line.Reset()
+		}
+	}
+}
+
+func (d *Display) PrintAgendaDay(day time.Time, isToday bool, reminders []*models.Reminder) {
+	heading := i18n.FormatWeekdayDate(day)
+	if isToday {
+		fmt.Println(themeColor(activeTheme.Colors.Header + " " + activeTheme.Colors.Countdown).Sprint(heading + " " + i18n.T("(today)")))
+	} else {
+		fmt.Println(themeColor(activeTheme.Colors.Header).Sprint(heading))
+	}
+
+	if len(reminders) == 0 {
+		fmt.Println(themeColor(activeTheme.Colors.Muted).Sprint("  " + i18n.T("nothing scheduled")))
+		return
+	}
+
+	for _, reminder := range reminders {
+		at := reminder.FormatTime()
+		if at == "" {
+			at = i18n.T("all day")
+		}
+		title := priorityColor(reminder.EffectivePriority()).Sprint(reminder.Title)
+		fmt.Printf("  %-7s [%d] %s%s\n", at, reminder.ID, title, formatLabels(reminder))
+	}
+}
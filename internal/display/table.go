@@ -0,0 +1,188 @@
+package display
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/fatih/color"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/models"
+)
+
+type Column string
+
+const (
+	ColumnID         Column = "id"
+	ColumnTitle      Column = "title"
+	ColumnDue        Column = "due"
+	ColumnTime       Column = "time"
+	ColumnIn         Column = "in"
+	ColumnRecurrence Column = "recurrence"
+	ColumnTags       Column = "tags"
+	ColumnPriority   Column = "priority"
+)
+
+const (
+	columnGap     = 2
+	minTitleWidth = 10
+)
+
+var Columns = []Column{ColumnID, ColumnTitle, ColumnDue, ColumnTime, ColumnIn, ColumnRecurrence, ColumnTags, ColumnPriority}
+
+var DefaultColumns = []Column{ColumnID, ColumnTitle, ColumnDue, ColumnTime, ColumnIn, ColumnPriority}
+
+func ParseColumns(input string) ([]Column, error) {
+	if strings.TrimSpace(input) == "" {
+		return DefaultColumns, nil
+	}
+
+	var columns []Column
+	for _, part := range strings.Split(input, ",") {
+		name := Column(strings.ToLower(strings.TrimSpace(part)))
+		if name == "" {
+			continue
+		}
+
+		known := false
+		for _, column := range Columns {
+			if column == name {
+				known = true
+				break
+			}
+		}
+		if !known {
+			names := make([]string, len(Columns))
+			for i, column := range Columns {
+				names[i] = string(column)
+			}
+			return nil, i18n.Errorf("invalid column %q, use: %s", part, strings.Join(names, ", "))
+		}
+		columns = append(columns, name)
+	}
+
+	if len(columns) == 0 {
+		return DefaultColumns, nil
+	}
+	return columns, nil
+}
+
+func (d *Display) PrintTable(reminders []*models.Reminder, columns []Column, now time.Time) {
+	rows := make([][]string, len(reminders))
+	for i, reminder := range reminders {
+		rows[i] = make([]string, len(columns))
+		for j, column := range columns {
+			rows[i][j] = cellValue(reminder, column, now)
+		}
+	}
+
+	widths := make([]int, len(columns))
+	for j, column := range columns {
+		widths[j] = utf8.RuneCountInString(strings.ToUpper(string(column)))
+		for _, row := range rows {
+			if width := utf8.RuneCountInString(row[j]); width > widths[j] {
+				widths[j] = width
+			}
+		}
+	}
+	fitTitle(columns, widths, TerminalWidth())
+
+	header := make([]string, len(columns))
+	for j, column := range columns {
+		header[j] = themeColor(activeTheme.Colors.Header).Sprint(padCell(strings.ToUpper(string(column)), widths[j]))
+	}
+	fmt.Println(strings.TrimRight(strings.Join(header, strings.Repeat(" ", columnGap)), " "))
+
+	for i, reminder := range reminders {
+		cells := make([]string, len(columns))
+		for j, column := range columns {
+			text := padCell(truncate(rows[i][j], widths[j]), widths[j])
+			cells[j] = cellColor(reminder, column).Sprint(text)
+		}
+		fmt.Println(strings.TrimRight(strings.Join(cells, strings.Repeat(" ", columnGap)), " "))
+	}
+}
+
+func cellValue(reminder *models.Reminder, column Column, now time.Time) string {
+	switch column {
+	case ColumnID:
+		return fmt.Sprintf("%d", reminder.ID)
+	case ColumnTitle:
+		return reminder.Title
+	case ColumnDue:
+		return i18n.FormatDate(reminder.DueDate)
+	case ColumnTime:
+		return reminder.FormatTime()
+	case ColumnIn:
+		return reminder.RelativeDue(now)
+	case ColumnRecurrence:
+		if !reminder.IsRecurrent {
+			return ""
+		}
+		return string(reminder.RecurrentType)
+	case ColumnTags:
+		return reminder.FormatTags()
+	case ColumnPriority:
+		return i18n.T(string(reminder.EffectivePriority()))
+	default:
+		return ""
+	}
+}
+
+func cellColor(reminder *models.Reminder, column Column) *color.Color {
+	switch column {
+	case ColumnTitle, ColumnPriority:
+		return priorityColor(reminder.EffectivePriority())
+	case ColumnIn:
+		if reminder.IsOverdue() || reminder.IsDue() {
+			return statusColor(reminder)
+		}
+		return themeColor(activeTheme.Colors.Countdown)
+	case ColumnTags:
+		return themeColor(activeTheme.Colors.Labels)
+	default:
+		return color.New(color.Reset)
+	}
+}
+
+func fitTitle(columns []Column, widths []int, termWidth int) {
+	titleIndex := -1
+	used := columnGap * (len(columns) - 1)
+	for j, column := range columns {
+		if column == ColumnTitle {
+			titleIndex = j
+			continue
+		}
+		used += widths[j]
+	}
+
+	if titleIndex < 0 {
+		return
+	}
+
+	available := termWidth - used
+	if available < minTitleWidth {
+		available = minTitleWidth
+	}
+	if widths[titleIndex] > available {
+		widths[titleIndex] = available
+	}
+}
+
+func truncate(text string, width int) string {
+	if utf8.RuneCountInString(text) <= width {
+		return text
+	}
+	if width <= 1 {
+		return "…"
+	}
+	return string([]rune(text)[:width-1]) + "…"
+}
+
+func padCell(text string, width int) string {
+	if padding := width - utf8.RuneCountInString(text); padding > 0 {
+		return text + strings.Repeat(" ", padding)
+	}
+	return text
+}
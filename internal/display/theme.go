@@ -0,0 +1,307 @@
+package display
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/common-nighthawk/go-figure"
+
+	"urgent-reminder/internal/i18n"
+)
+
+const (
+	DefaultThemeName = "default"
+	themesDir        = "themes"
+	bannerNone       = "none"
+)
+
+type Theme struct {
+	Name      string         `json:"-"`
+	Extends   string         `json:"extends,omitempty"`
+	Colors    ThemeColors    `json:"colors"`
+	Banner    ThemeBanner    `json:"banner"`
+	Separator ThemeSeparator `json:"separator"`
+	Glyphs    ThemeGlyphs    `json:"glyphs"`
+}
+
+type ThemeColors struct {
+	Overdue          string `json:"overdue"`
+	Due              string `json:"due"`
+	Upcoming         string `json:"upcoming"`
+	Countdown        string `json:"countdown"`
+	Blocked          string `json:"blocked"`
+	Today            string `json:"today"`
+	Success          string `json:"success"`
+	Error            string `json:"error"`
+	Info             string `json:"info"`
+	Warning          string `json:"warning"`
+	Loud             string `json:"loud"`
+	Header           string `json:"header"`
+	Label            string `json:"label"`
+	Labels           string `json:"labels"`
+	Muted            string `json:"muted"`
+	PriorityLow      string `json:"priority_low"`
+	PriorityNormal   string `json:"priority_normal"`
+	PriorityHigh     string `json:"priority_high"`
+	PriorityCritical string `json:"priority_critical"`
+}
+
+type ThemeBanner struct {
+	Lines []string `json:"lines"`
+	Font  string   `json:"font"`
+	Color string   `json:"color"`
+}
+
+type ThemeSeparator struct {
+	Char  string `json:"char"`
+	Width int    `json:"width"`
+}
+
+type ThemeGlyphs struct {
+	Success  string `json:"success"`
+	Overdue  string `json:"overdue"`
+	Due      string `json:"due"`
+	Upcoming string `json:"upcoming"`
+	Blocked  string `json:"blocked"`
+	Done     string `json:"done"`
+	Pending  string `json:"pending"`
+}
+
+var builtinThemes = map[string]Theme{
+	DefaultThemeName: {
+		Colors: ThemeColors{
+			Overdue:          "red",
+			Due:              "yellow",
+			Upcoming:         "hiblack",
+			Countdown:        "cyan",
+			Blocked:          "hiblack",
+			Today:            "reverse",
+			Success:          "green",
+			Error:            "red",
+			Info:             "blue",
+			Warning:          "yellow",
+			Loud:             "bold red reverse",
+			Header:           "bold",
+			Label:            "bold",
+			Labels:           "cyan",
+			Muted:            "hiblack",
+			PriorityLow:      "hiblack",
+			PriorityHigh:     "bold yellow",
+			PriorityCritical: "bold red",
+		},
+		Banner:    ThemeBanner{Lines: []string{"URGENT", "REMINDERS"}},
+		Separator: ThemeSeparator{Char: "=", Width: 60},
+		Glyphs:    ThemeGlyphs{Success: "✓", Done: "x", Pending: " "},
+	},
+	"minimal": {
+		Colors: ThemeColors{
+			Overdue: "red",
+			Due:     "yellow",
+			Error:   "red",
+			Warning: "yellow",
+			Loud:    "bold",
+			Header:  "bold",
+			Today:   "underline",
+			Muted:   "faint",
+		},
+		Banner:    ThemeBanner{Lines: []string{"URGENT", "REMINDERS"}, Font: bannerNone},
+		Separator: ThemeSeparator{Char: "-", Width: 40},
+		Glyphs:    ThemeGlyphs{Success: "✓", Done: "x", Pending: " "},
+	},
+	"high-contrast": {
+		Colors: ThemeColors{
+			Overdue:          "bold hiwhite on-red",
+			Due:              "bold black on-hiyellow",
+			Upcoming:         "bold hiwhite",
+			Countdown:        "bold hicyan",
+			Blocked:          "bold himagenta",
+			Today:            "bold black on-hiwhite",
+			Success:          "bold higreen",
+			Error:            "bold hiwhite on-red",
+			Info:             "bold hiwhite",
+			Warning:          "bold hiyellow",
+			Loud:             "bold hiwhite on-red",
+			Header:           "bold underline hiwhite",
+			Label:            "bold hiwhite",
+			Labels:           "bold hicyan",
+			Muted:            "hiwhite",
+			PriorityLow:      "hiwhite",
+			PriorityNormal:   "bold hiwhite",
+			PriorityHigh:     "bold hiyellow",
+			PriorityCritical: "bold hiwhite on-red",
+		},
+		Banner:    ThemeBanner{Lines: []string{"URGENT", "REMINDERS"}, Font: "banner3", Color: "bold hiwhite"},
+		Separator: ThemeSeparator{Char: "█", Width: 60},
+		Glyphs: ThemeGlyphs{
+			Success:  "✔",
+			Overdue:  "‼",
+			Due:      "!",
+			Upcoming: "»",
+			Blocked:  "✖",
+			Done:     "✔",
+			Pending:  " ",
+		},
+	},
+	"solarized": {
+		Colors: ThemeColors{
+			Overdue:          "#dc322f",
+			Due:              "#b58900",
+			Upcoming:         "#586e75",
+			Countdown:        "#2aa198",
+			Blocked:          "#586e75",
+			Today:            "#fdf6e3 on-#268bd2",
+			Success:          "#859900",
+			Error:            "#dc322f",
+			Info:             "#268bd2",
+			Warning:          "#cb4b16",
+			Loud:             "bold #fdf6e3 on-#dc322f",
+			Header:           "bold #268bd2",
+			Label:            "bold #93a1a1",
+			Labels:           "#6c71c4",
+			Muted:            "#586e75",
+			PriorityLow:      "#586e75",
+			PriorityHigh:     "bold #cb4b16",
+			PriorityCritical: "bold #dc322f",
+		},
+		Banner:    ThemeBanner{Lines: []string{"URGENT", "REMINDERS"}, Font: "slant", Color: "#d33682"},
+		Separator: ThemeSeparator{Char: "─", Width: 60},
+		Glyphs: ThemeGlyphs{
+			Success:  "✓",
+			Overdue:  "●",
+			Due:      "◐",
+			Upcoming: "○",
+			Blocked:  "⊘",
+			Done:     "✓",
+			Pending:  " ",
+		},
+	},
+}
+
+var activeTheme = DefaultTheme()
+
+func DefaultTheme() *Theme {
+	theme := builtinThemes[DefaultThemeName]
+	theme.Name = DefaultThemeName
+	return &theme
+}
+
+func SetTheme(theme *Theme) {
+	activeTheme = theme
+}
+
+func ThemeNames() []string {
+	names := make([]string, 0, len(builtinThemes))
+	for name := range builtinThemes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func LoadTheme(name, configDir string) (*Theme, error) {
+	if name == "" {
+		name = DefaultThemeName
+	}
+
+	if builtin, ok := builtinThemes[name]; ok {
+		builtin.Name = name
+		return &builtin, nil
+	}
+
+	path := name
+	if !strings.HasSuffix(name, ".json") {
+		path = filepath.Join(configDir, themesDir, name+".json")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, i18n.Errorf("theme %q not found, use one of %s or a file in %s", name, strings.Join(ThemeNames(), ", "), filepath.Join(configDir, themesDir))
+		}
+		return nil, i18n.Errorf("failed to read theme file: %w", err)
+	}
+
+	var base struct {
+		Extends string `json:"extends"`
+	}
+	if err := json.Unmarshal(data, &base); err != nil {
+		return nil, i18n.Errorf("failed to parse theme file %s: %w", path, err)
+	}
+	if base.Extends == "" {
+		base.Extends = DefaultThemeName
+	}
+	parent, ok := builtinThemes[base.Extends]
+	if !ok {
+		return nil, i18n.Errorf("theme %s extends unknown theme %q", path, base.Extends)
+	}
+
+	theme := parent
+	theme.Banner.Lines = append([]string(nil), parent.Banner.Lines...)
+	if err := json.Unmarshal(data, &theme); err != nil {
+		return nil, i18n.Errorf("failed to parse theme file %s: %w", path, err)
+	}
+	theme.Name = name
+
+	if err := theme.validate(); err != nil {
+		return nil, i18n.Errorf("invalid theme file %s: %w", path, err)
+	}
+	return &theme, nil
+}
+
+func (t *Theme) validate() error {
+	if t.Separator.Width < 0 {
+		return i18n.Errorf("separator width cannot be negative")
+	}
+	specs := t.colorSpecs()
+	names := make([]string, 0, len(specs))
+	for name := range specs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := ValidateColor(specs[name]); err != nil {
+			return i18n.Errorf("color %s: %w", name, err)
+		}
+	}
+	font := t.Banner.Font
+	if font == "" || font == bannerNone {
+		return nil
+	}
+	if _, err := figure.Asset("fonts/" + font + ".flf"); err != nil {
+		return i18n.Errorf("unknown banner font %q", font)
+	}
+	return nil
+}
+
+func (t *Theme) colorSpecs() map[string]string {
+	c := t.Colors
+	return map[string]string{
+		"overdue":           c.Overdue,
+		"due":               c.Due,
+		"upcoming":          c.Upcoming,
+		"countdown":         c.Countdown,
+		"blocked":           c.Blocked,
+		"today":             c.Today,
+		"success":           c.Success,
+		"error":             c.Error,
+		"info":              c.Info,
+		"warning":           c.Warning,
+		"loud":              c.Loud,
+		"header":            c.Header,
+		"label":             c.Label,
+		"labels":            c.Labels,
+		"muted":             c.Muted,
+		"priority_low":      c.PriorityLow,
+		"priority_normal":   c.PriorityNormal,
+		"priority_high":     c.PriorityHigh,
+		"priority_critical": c.PriorityCritical,
+		"banner.color":      t.Banner.Color,
+	}
+}
+
+func (t *Theme) HasBanner() bool {
+	return t.Banner.Font != bannerNone && len(t.Banner.Lines) > 0
+}
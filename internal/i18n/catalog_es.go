@@ -0,0 +1,322 @@
+package i18n
+
+var esDates = dateNames{
+	dateLayout:    "02/01/2006",
+	weekdays:      [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+	weekdaysShort: [7]string{"Dom", "Lun", "Mar", "Mié", "Jue", "Vie", "Sáb"},
+	months:        [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	monthsShort:   [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sep", "oct", "nov", "dic"},
+	monthYear:     "%[1]s de %[2]d",
+	dayMonth:      "%02[1]d %[2]s",
+}
+
+var esMessages = map[string]string{
+	"  # or restart your terminal":      "  # o reinicia la terminal",
+	"  Unblocked [%d] %s":               "  Desbloqueado [%d] %s",
+	"%d overdue":                        "%d vencido(s)",
+	"%d reminder(s) %s":                 "%d recordatorio(s) %s",
+	"%d today":                          "%d hoy",
+	"%d/%d done":                        "%d/%d hechas",
+	"%dw %dd":                           "%dsem %dd",
+	"%dw":                               "%dsem",
+	"%dd %dh":                           "%dd %dh",
+	"%dd":                               "%dd",
+	"%dh %dm":                           "%dh %dmin",
+	"%dh":                               "%dh",
+	"%dm":                               "%dmin",
+	"%s and %s":                         "%s y %s",
+	"%s at %s":                          "%s a las %s",
+	"%s, optional, press Enter to skip": "%s, opcional, pulsa Enter para omitir",
+	"(blocked by %s)":                   "(bloqueado por %s)",
+	"(none)":                            "(ninguno)",
+	"(today)":                           "(hoy)",
+	"Aborted.":                          "Cancelado.",
+	"Add cancelled.":                    "Alta cancelada.",
+	"Add more days?":                    "¿Agregar más días?",
+	"All subtasks are done. Run 'urgent-reminder check %d' to complete the reminder.": "Todas las subtareas están hechas. Ejecuta 'urgent-reminder check %d' para completar el recordatorio.",
+	"Blocked by":            "Bloqueado por",
+	"Blocked:":              "Bloqueados:",
+	"Blocking":              "Bloqueando",
+	"Config file: %s":       "Archivo de configuración: %s",
+	"Configuration Files":   "Archivos de configuración",
+	"Created":               "Creado",
+	"Data directory: %s":    "Directorio de datos: %s",
+	"Data file: %s":         "Archivo de datos: %s",
+	"Date (%s)":             "Fecha (%s)",
+	"Date: %s":              "Fecha: %s",
+	"Day of month (1-31)":   "Día del mes (1-31)",
+	"Day of month":          "Día del mes",
+	"Days":                  "Días",
+	"Delete [%d] %s? (y/N)": "¿Eliminar [%d] %s? (y/N)",
+	"Delete anyway":         "Eliminar de todos modos",
+	"Delete cancelled.":     "Eliminación cancelada.",
+	"Deleting it will leave them without this blocker.": "Eliminarlo los dejará sin este bloqueo.",
+	"Detected shell: %s": "Shell detectada: %s",
+	"Done":               "Listo",
+	"Due date":           "Vencimiento",
+	"Due date: ":         "Vencimiento: ",
+	"Due":                "Vence",
+	"Edit %s/%s.json to add placeholders such as {{.sprint}}.":                  "Edita %s/%s.json para agregar variables como {{.sprint}}.",
+	"Editing reminder [%d]. Lines starting with '#' are ignored in the header.": "Editando el recordatorio [%d]. Las líneas que empiezan con '#' se ignoran en la cabecera.",
+	"Error: %s":             "Error: %s",
+	"Filter: ":              "Filtro: ",
+	"Found: %d reminder(s)": "Encontrado(s): %d recordatorio(s)",
+	"Hidden until":          "Oculto hasta",
+	"Hidden until: %s":      "Oculto hasta: %s",
+	"Hide until (%s, optional, press Enter to skip)": "Ocultar hasta (%s, opcional, pulsa Enter para omitir)",
+	"ID: %d":                               "ID: %d",
+	"Is this reminder recurrent?":          "¿Este recordatorio es recurrente?",
+	"Links":                                "Enlaces",
+	"Links:":                               "Enlaces:",
+	"Next occurrences:":                    "Próximas apariciones:",
+	"Next":                                 "Próximas",
+	"No changes made.":                     "No se hicieron cambios.",
+	"No due reminders found.":              "No se encontraron recordatorios pendientes.",
+	"No matching reminders found.":         "No se encontraron recordatorios coincidentes.",
+	"No reminders match %q.":               "Ningún recordatorio coincide con %q.",
+	"No reminders selected.":               "No se seleccionaron recordatorios.",
+	"No reminders. Press a to add one.":    "No hay recordatorios. Pulsa a para agregar uno.",
+	"No tags found.":                       "No se encontraron etiquetas.",
+	"No templates found.":                  "No se encontraron plantillas.",
+	"No unblocked reminders found.":        "No se encontraron recordatorios desbloqueados.",
+	"No":                                   "No",
+	"Notes go after the first blank line.": "Las notas van después de la primera línea en blanco.",
+	"Notes:":                               "Notas:",
+	"Opening %s":                           "Abriendo %s",
+	"Priority":                             "Prioridad",
+	"Priority: %s":                         "Prioridad: %s",
+	"Project (optional, press Enter to skip)": "Proyecto (opcional, pulsa Enter para omitir)",
+	"Project":                           "Proyecto",
+	"Project: %s":                       "Proyecto: %s",
+	"Recurrence type":                   "Tipo de recurrencia",
+	"Recurrence":                        "Recurrencia",
+	"Recurrent":                         "Recurrente",
+	"Recurrent: %s":                     "Recurrente: %s",
+	"Reminder [%d] %s deleted":          "Recordatorio [%d] %s eliminado",
+	"Reminder [%d] %s snoozed until %s": "Recordatorio [%d] %s pospuesto hasta %s",
+	"Reminder [%d] updated":             "Recordatorio [%d] actualizado",
+	"Reminder added successfully!":      "¡Recordatorio agregado correctamente!",
+	"Repeats":                           "Se repite",
+	"Scheduled start date (%s, optional, press Enter to skip)": "Fecha de inicio programada (%s, opcional, pulsa Enter para omitir)",
+	"Scheduled":                                  "Programado",
+	"Scheduled: %s":                              "Programado: %s",
+	"Select days (multi-select)":                 "Selecciona los días (selección múltiple)",
+	"Select reminders to check":                  "Selecciona los recordatorios a completar",
+	"Shell integration already configured!":      "¡La integración con la shell ya está configurada!",
+	"Shell integration configured successfully!": "¡Integración con la shell configurada correctamente!",
+	"Snooze for: ":                               "Posponer por: ",
+	"Start date (%s)":                            "Fecha de inicio (%s)",
+	"Status":                                     "Estado",
+	"Subtask %d added to [%d] %s":                "Subtarea %d agregada a [%d] %s",
+	"Subtasks (%d/%d):":                          "Subtareas (%d/%d):",
+	"Subtasks":                                   "Subtareas",
+	"Tags (e.g. #ops #billing, optional, press Enter to skip)": "Etiquetas (p. ej. #ops #billing, opcional, pulsa Enter para omitir)",
+	"Tags":                           "Etiquetas",
+	"Tags: %s":                       "Etiquetas: %s",
+	"Template %q deleted":            "Plantilla %q eliminada",
+	"Template %q saved from [%d] %s": "Plantilla %q guardada a partir de [%d] %s",
+	"The urgent_reminder_list function has been added to your shell config.": "La función urgent_reminder_list se agregó a la configuración de tu shell.",
+	"The urgent_reminder_list function is already in your shell config.":     "La función urgent_reminder_list ya está en la configuración de tu shell.",
+	"Themes directory: %s": "Directorio de temas: %s",
+	"This will automatically run 'urgent-reminder list%s' in new terminals.": "Esto ejecutará 'urgent-reminder list%s' automáticamente en las terminales nuevas.",
+	"Time (HH:MM, optional, press Enter to skip)":                            "Hora (HH:MM, opcional, pulsa Enter para omitir)",
+	"Time":      "Hora",
+	"Time: %s":  "Hora: %s",
+	"Title":     "Título",
+	"Title: ":   "Título: ",
+	"Title: %s": "Título: %s",
+	"To apply changes (if you just set this up manually):": "Para aplicar los cambios (si acabas de configurarlo manualmente):",
+	"To apply changes:":                           "Para aplicar los cambios:",
+	"To change data location, set XDG_DATA_HOME:": "Para cambiar la ubicación de los datos, define XDG_DATA_HOME:",
+	"Total: %d URGENT REMINDER(S)":                "Total: %d RECORDATORIO(S) URGENTE(S)",
+	"Total: %d URGENT REMINDER(S), %d upcoming":   "Total: %d RECORDATORIO(S) URGENTE(S), %d próximo(s)",
+	"Total: %d reminder(s) in %s":                 "Total: %d recordatorio(s) en %s",
+	"Total: %d reminder(s) this week":             "Total: %d recordatorio(s) esta semana",
+	"Upcoming:":                                   "Próximos:",
+	"Urgency":                                     "Urgencia",
+	"Use one \"Link:\" line per URL or file path and one \"Field: name=value\" line per custom field.": "Usa una línea \"Link:\" por URL o ruta de archivo y una línea \"Field: nombre=valor\" por campo personalizado.",
+	"Warn before (e.g. 7d,1d,2h, optional, press Enter to skip)":                                       "Avisar antes (p. ej. 7d,1d,2h, opcional, pulsa Enter para omitir)",
+	"Warn before":                   "Avisar antes",
+	"Warn before: %s":               "Avisar antes: %s",
+	"Warning: [%d] %s is blocking:": "Atención: [%d] %s está bloqueando:",
+	"When":                          "Cuándo",
+	"Overdue by":                    "Vencido hace",
+	"Yes":                           "Sí",
+	"[%d] %s added, press e to fill in the details":     "[%d] %s agregado, pulsa e para completar los detalles",
+	"[%d] %s completed":                                 "[%d] %s completado",
+	"[%d] %s deleted":                                   "[%d] %s eliminado",
+	"[%d] %s is blocked by [%d] %s":                     "[%d] %s está bloqueado por [%d] %s",
+	"[%d] %s is no longer blocked by [%d] %s":           "[%d] %s ya no está bloqueado por [%d] %s",
+	"[%d] %s is no longer blocked":                      "[%d] %s ya no está bloqueado",
+	"[%d] %s snoozed until %s":                          "[%d] %s pospuesto hasta %s",
+	"[%d] %s, next due %s":                              "[%d] %s, próximo vencimiento %s",
+	"[%d] %s: %d/%d subtasks done":                      "[%d] %s: %d/%d subtareas hechas",
+	"[%d] %s: advanced to next cycle, next due date %s": "[%d] %s: avanzó al siguiente ciclo, próximo vencimiento %s",
+	"[%d] %s: completed and deleted":                    "[%d] %s: completado y eliminado",
+	"all day":                                           "todo el día",
+	"does not repeat":                                   "no se repite",
+	"due now":                                           "vence ahora",
+	"due today":                                         "vence hoy",
+	"enter a number between 1 and 31":                   "introduce un número entre 1 y 31",
+	"enter to confirm, esc to cancel":                   "enter para confirmar, esc para cancelar",
+	"every %s":                                          "cada %s",
+	"every month on day %d":                             "cada mes el día %d",
+	"every other %s":                                    "%s, cada dos semanas",
+	"every other week":                                  "cada dos semanas",
+	"every week":                                        "cada semana",
+	"every year":                                        "cada año",
+	"filter: %q (esc to clear) · ":                      "filtro: %q (esc para borrar) · ",
+	"in %s":                                             "en %s",
+	"invalid date format, use %s":                       "formato de fecha no válido, usa %s",
+	"invalid month %q, use YYYY-MM, 1-12 or a month name": "mes no válido %q, usa YYYY-MM, 1-12 o el nombre del mes",
+	"invalid weekday %q": "día de la semana no válido %q",
+	"j/k move · c check · s snooze · e edit · d delete · a add · / filter · r reload · q quit": "j/k mover · c completar · s posponer · e editar · d eliminar · a agregar · / filtrar · r recargar · q salir",
+	"no":                                  "no",
+	"nothing scheduled":                   "nada programado",
+	"overdue %s":                          "vencido hace %s",
+	"tier %q command failed for [%d]: %v": "falló el comando del nivel %q para [%d]: %v",
+	"title cannot be empty":               "el título no puede estar vacío",
+	"title or notes":                      "título o notas",
+	"yes":                                 "sí",
+	"overdue":                             "vencido",
+	"due":                                 "pendiente",
+	"upcoming":                            "próximo",
+	"critical":                            "crítico",
+	"low":                                 "baja",
+	"normal":                              "normal",
+	"high":                                "alta",
+	"weekly":                              "semanal",
+	"bi-weekly":                           "quincenal",
+	"monthly":                             "mensual",
+	"Overdue":                             "Vencidos",
+	"Today":                               "Hoy",
+	"Upcoming":                            "Próximos",
+	"failed to initialize storage: %w":    "no se pudo inicializar el almacenamiento: %w",
+	"prompt failed: %w":                   "falló la pregunta: %w",
+	"failed to get next ID: %w":           "no se pudo obtener el siguiente ID: %w",
+	"invalid time format, use HH:MM":      "formato de hora no válido, usa HH:MM",
+	"failed to add reminder: %w":          "no se pudo agregar el recordatorio: %w",
+	"failed to initialize template storage: %w":                            "no se pudo inicializar el almacenamiento de plantillas: %w",
+	"invalid variable %q, use name=value":                                  "variable no válida %q, usa nombre=valor",
+	"invalid date format, use YYYY-MM-DD":                                  "formato de fecha no válido, usa YYYY-MM-DD",
+	"template %q: %w":                                                      "plantilla %q: %w",
+	"at least one blocker is required, use --by":                           "se necesita al menos un bloqueador, usa --by",
+	"failed to get reminder: %w":                                           "no se pudo obtener el recordatorio: %w",
+	"failed to get blocker: %w":                                            "no se pudo obtener el bloqueador: %w",
+	"failed to add blocker: %w":                                            "no se pudo agregar el bloqueador: %w",
+	"failed to remove blockers: %w":                                        "no se pudieron quitar los bloqueadores: %w",
+	"failed to remove blocker: %w":                                         "no se pudo quitar el bloqueador: %w",
+	"failed to load reminders: %w":                                         "no se pudieron cargar los recordatorios: %w",
+	"%d of %d reminder(s) could not be checked":                            "%d de %d recordatorio(s) no se pudieron completar",
+	"failed to find dependents: %w":                                        "no se pudieron encontrar los dependientes: %w",
+	"failed to update reminder: %w":                                        "no se pudo actualizar el recordatorio: %w",
+	"failed to delete reminder: %w":                                        "no se pudo eliminar el recordatorio: %w",
+	"failed to get updated reminder: %w":                                   "no se pudo obtener el recordatorio actualizado: %w",
+	"failed to list reminders: %w":                                         "no se pudieron listar los recordatorios: %w",
+	"range %s spans more than %d IDs":                                      "el rango %s abarca más de %d IDs",
+	"failed to get home directory: %w":                                     "no se pudo obtener el directorio personal: %w",
+	"invalid reminder: %w":                                                 "recordatorio no válido: %w",
+	"header line %q is not in \"Key: value\" form":                         "la línea de cabecera %q no tiene el formato \"Key: value\"",
+	"field line %q is not in \"Field: name=value\" form":                   "la línea de campo %q no tiene el formato \"Field: name=value\"",
+	"unknown header %q":                                                    "cabecera desconocida %q",
+	"editor %q failed: %w":                                                 "falló el editor %q: %w",
+	"failed to create temporary file: %w":                                  "no se pudo crear el archivo temporal: %w",
+	"failed to write temporary file: %w":                                   "no se pudo escribir el archivo temporal: %w",
+	"failed to read temporary file: %w":                                    "no se pudo leer el archivo temporal: %w",
+	"invalid field filter %q, use name=value":                              "filtro de campo no válido %q, usa nombre=valor",
+	"--recurrent and --one-off cannot be used together":                    "--recurrent y --one-off no se pueden usar juntos",
+	"reminder [%d] has no links, add one with 'urgent-reminder edit %d'":   "el recordatorio [%d] no tiene enlaces, agrega uno con 'urgent-reminder edit %d'",
+	"failed to open %s: %w":                                                "no se pudo abrir %s: %w",
+	"--format and --output cannot be used together":                        "--format y --output no se pueden usar juntos",
+	"--columns cannot be used with --output %s":                            "--columns no se puede usar con --output %s",
+	"invalid prompt format: %w":                                            "formato de prompt no válido: %w",
+	"failed to render prompt format: %w":                                   "no se pudo generar el formato del prompt: %w",
+	"unsupported shell %q, use bash, zsh or starship":                      "shell no compatible %q, usa bash, zsh o starship",
+	"failed to summarize reminders: %w":                                    "no se pudieron resumir los recordatorios: %w",
+	"--regex and --fuzzy cannot be used together":                          "--regex y --fuzzy no se pueden usar juntos",
+	"failed to search reminders: %w":                                       "no se pudieron buscar los recordatorios: %w",
+	"failed to read config file: %w":                                       "no se pudo leer el archivo de configuración: %w",
+	"failed to open config file: %w":                                       "no se pudo abrir el archivo de configuración: %w",
+	"failed to write to config file: %w":                                   "no se pudo escribir en el archivo de configuración: %w",
+	"failed to snooze reminder: %w":                                        "no se pudo posponer el recordatorio: %w",
+	"subtask cannot be empty":                                              "la subtarea no puede estar vacía",
+	"failed to add subtask: %w":                                            "no se pudo agregar la subtarea: %w",
+	"invalid subtask number: %s":                                           "número de subtarea no válido: %s",
+	"failed to update subtask: %w":                                         "no se pudo actualizar la subtarea: %w",
+	"failed to list tags: %w":                                              "no se pudieron listar las etiquetas: %w",
+	"template %q already exists, use --force to overwrite it":              "la plantilla %q ya existe, usa --force para sobrescribirla",
+	"failed to marshal template: %w":                                       "no se pudo serializar la plantilla: %w",
+	"failed to run tui: %w":                                                "no se pudo ejecutar la tui: %w",
+	"failed to parse config file %s: %w":                                   "no se pudo interpretar el archivo de configuración %s: %w",
+	"invalid config file %s: %w":                                           "archivo de configuración no válido %s: %w",
+	"format %q cannot be empty":                                            "el formato %q no puede estar vacío",
+	"invalid field name %q, use lowercase letters, digits, '-' and '_'":    "nombre de campo no válido %q, usa minúsculas, dígitos, '-' y '_'",
+	"field %q must be an integer":                                          "el campo %q debe ser un número entero",
+	"field %q must be a date (YYYY-MM-DD)":                                 "el campo %q debe ser una fecha (YYYY-MM-DD)",
+	"field %q must be one of: %s":                                          "el campo %q debe ser uno de: %s",
+	"enum field %q needs a list of values":                                 "el campo enum %q necesita una lista de valores",
+	"field %q has unknown type %q, use string, int, date or enum":          "el campo %q tiene el tipo desconocido %q, usa string, int, date o enum",
+	"every tier needs a name":                                              "cada nivel necesita un nombre",
+	"tier %q: %w":                                                          "nivel %q: %w",
+	"invalid column %q, use: %s":                                           "columna no válida %q, usa: %s",
+	"theme %q not found, use one of %s or a file in %s":                    "no se encontró el tema %q, usa uno de %s o un archivo en %s",
+	"failed to read theme file: %w":                                        "no se pudo leer el archivo de tema: %w",
+	"failed to parse theme file %s: %w":                                    "no se pudo interpretar el archivo de tema %s: %w",
+	"theme %s extends unknown theme %q":                                    "el tema %s extiende el tema desconocido %q",
+	"invalid theme file %s: %w":                                            "archivo de tema no válido %s: %w",
+	"separator width cannot be negative":                                   "el ancho del separador no puede ser negativo",
+	"unknown banner font %q":                                               "fuente de banner desconocida %q",
+	"invalid priority %q, use low, normal, high or critical":               "prioridad no válida %q, usa low, normal, high o critical",
+	"lead time %q must be positive":                                        "la antelación %q debe ser positiva",
+	"template %q: invalid %s: %w":                                          "plantilla %q: %s no válido: %w",
+	"template %q: %s: %w":                                                  "plantilla %q: %s: %w",
+	"template %q: title cannot be empty":                                   "plantilla %q: el título no puede estar vacío",
+	"invalid output format %q, use one of: %s":                             "formato de salida no válido %q, usa uno de: %s",
+	"output format %q is not supported here":                               "el formato de salida %q no se admite aquí",
+	"invalid format %q: %w":                                                "formato no válido %q: %w",
+	"failed to render format: %w":                                          "no se pudo generar el formato: %w",
+	"a reminder cannot block itself":                                       "un recordatorio no puede bloquearse a sí mismo",
+	"reminder with ID %d not found":                                        "no se encontró el recordatorio con ID %d",
+	"reminder %d cannot be blocked by %d: that would create a cycle %s":    "el recordatorio %d no puede estar bloqueado por %d: eso crearía un ciclo %s",
+	"invalid sort field %q, use one of: %s":                                "campo de orden no válido %q, usa uno de: %s",
+	"failed to calculate next due date: %w":                                "no se pudo calcular el próximo vencimiento: %w",
+	"reminder with ID %d has no subtask %d":                                "el recordatorio con ID %d no tiene la subtarea %d",
+	"snooze duration must be positive":                                     "la duración del aplazamiento debe ser positiva",
+	"invalid regular expression: %w":                                       "expresión regular no válida: %w",
+	"empty reminder reference":                                             "referencia de recordatorio vacía",
+	"UUID prefix %q matches %d reminders, use more characters":             "el prefijo de UUID %q coincide con %d recordatorios, usa más caracteres",
+	"no reminder matches %q":                                               "ningún recordatorio coincide con %q",
+	"%q matches %d reminders: %s":                                          "%q coincide con %d recordatorios: %s",
+	"failed to marshal cache: %w":                                          "no se pudo serializar la caché: %w",
+	"failed to create cache directory: %w":                                 "no se pudo crear el directorio de caché: %w",
+	"failed to write cache file: %w":                                       "no se pudo escribir el archivo de caché: %w",
+	"failed to create data directory: %w":                                  "no se pudo crear el directorio de datos: %w",
+	"failed to read reminders file: %w":                                    "no se pudo leer el archivo de recordatorios: %w",
+	"failed to migrate old format: %w":                                     "no se pudo migrar el formato antiguo: %w",
+	"failed to save migrated reminders: %w":                                "no se pudieron guardar los recordatorios migrados: %w",
+	"failed to parse reminders: %w":                                        "no se pudieron interpretar los recordatorios: %w",
+	"failed to marshal reminders: %w":                                      "no se pudieron serializar los recordatorios: %w",
+	"failed to write reminders file: %w":                                   "no se pudo escribir el archivo de recordatorios: %w",
+	"template %q not found":                                                "no se encontró la plantilla %q",
+	"failed to read template: %w":                                          "no se pudo leer la plantilla: %w",
+	"failed to parse template %q: %w":                                      "no se pudo interpretar la plantilla %q: %w",
+	"failed to create templates directory: %w":                             "no se pudo crear el directorio de plantillas: %w",
+	"failed to write template file: %w":                                    "no se pudo escribir el archivo de plantilla: %w",
+	"failed to delete template: %w":                                        "no se pudo eliminar la plantilla: %w",
+	"failed to read templates directory: %w":                               "no se pudo leer el directorio de plantillas: %w",
+	"invalid template name %q, use lowercase letters, digits, '-' and '_'": "nombre de plantilla no válido %q, usa minúsculas, dígitos, '-' y '_'",
+	"empty duration":                                                       "duración vacía",
+	"invalid duration %q, use e.g. 2h, 7d or 2w":                           "duración no válida %q, usa p. ej. 2h, 7d o 2w",
+	"invalid duration %q: %w":                                              "duración no válida %q: %w",
+	"invalid duration unit %q in %q":                                       "unidad de duración no válida %q en %q",
+	"invalid date format %q, use YYYY-MM-DD":                               "formato de fecha no válido %q, usa YYYY-MM-DD",
+	"Error:":                                                               "Error:",
+	"Warning: %v, using the default theme":                                 "Atención: %v, se usa el tema predeterminado",
+	"unknown color or attribute %q in %q":                                  "color o atributo desconocido %q en %q",
+	"color %s: %w":                                                         "color %s: %w",
+	"%q only partially matches [%d] %s, use it":                            "%q solo coincide en parte con [%d] %s, usarlo",
+	"partial title match %q was not confirmed":                             "no se confirmó la coincidencia parcial de título %q",
+	"Warning: %v, using English":                                           "Atención: %v, se usa inglés",
+	"--date and --var can only be used with --template":                    "--date y --var solo se pueden usar con --template",
+	"template %q: field %q is not declared in the config file":             "plantilla %q: el campo %q no está declarado en el archivo de configuración",
+}
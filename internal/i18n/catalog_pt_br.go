@@ -0,0 +1,322 @@
+package i18n
+
+var ptBRDates = dateNames{
+	dateLayout:    "02/01/2006",
+	weekdays:      [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+	weekdaysShort: [7]string{"Dom", "Seg", "Ter", "Qua", "Qui", "Sex", "Sáb"},
+	months:        [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+	monthsShort:   [12]string{"jan", "fev", "mar", "abr", "mai", "jun", "jul", "ago", "set", "out", "nov", "dez"},
+	monthYear:     "%[1]s de %[2]d",
+	dayMonth:      "%02[1]d %[2]s",
+}
+
+var ptBRMessages = map[string]string{
+	"  # or restart your terminal":      "  # ou reinicie o terminal",
+	"  Unblocked [%d] %s":               "  Desbloqueado [%d] %s",
+	"%d overdue":                        "%d atrasado(s)",
+	"%d reminder(s) %s":                 "%d lembrete(s) %s",
+	"%d today":                          "%d hoje",
+	"%d/%d done":                        "%d/%d concluídas",
+	"%dw %dd":                           "%dsem %dd",
+	"%dw":                               "%dsem",
+	"%dd %dh":                           "%dd %dh",
+	"%dd":                               "%dd",
+	"%dh %dm":                           "%dh %dmin",
+	"%dh":                               "%dh",
+	"%dm":                               "%dmin",
+	"%s and %s":                         "%s e %s",
+	"%s at %s":                          "%s às %s",
+	"%s, optional, press Enter to skip": "%s, opcional, pressione Enter para pular",
+	"(blocked by %s)":                   "(bloqueado por %s)",
+	"(none)":                            "(nenhum)",
+	"(today)":                           "(hoje)",
+	"Aborted.":                          "Cancelado.",
+	"Add cancelled.":                    "Adição cancelada.",
+	"Add more days?":                    "Adicionar mais dias?",
+	"All subtasks are done. Run 'urgent-reminder check %d' to complete the reminder.": "Todas as subtarefas foram concluídas. Execute 'urgent-reminder check %d' para concluir o lembrete.",
+	"Blocked by":            "Bloqueado por",
+	"Blocked:":              "Bloqueados:",
+	"Blocking":              "Bloqueando",
+	"Config file: %s":       "Arquivo de configuração: %s",
+	"Configuration Files":   "Arquivos de configuração",
+	"Created":               "Criado em",
+	"Data directory: %s":    "Diretório de dados: %s",
+	"Data file: %s":         "Arquivo de dados: %s",
+	"Date (%s)":             "Data (%s)",
+	"Date: %s":              "Data: %s",
+	"Day of month (1-31)":   "Dia do mês (1-31)",
+	"Day of month":          "Dia do mês",
+	"Days":                  "Dias",
+	"Delete [%d] %s? (y/N)": "Excluir [%d] %s? (y/N)",
+	"Delete anyway":         "Excluir mesmo assim",
+	"Delete cancelled.":     "Exclusão cancelada.",
+	"Deleting it will leave them without this blocker.": "Excluí-lo deixará esses lembretes sem este bloqueio.",
+	"Detected shell: %s": "Shell detectado: %s",
+	"Done":               "Concluir",
+	"Due date":           "Vencimento",
+	"Due date: ":         "Vencimento: ",
+	"Due":                "Vence",
+	"Edit %s/%s.json to add placeholders such as {{.sprint}}.":                  "Edite %s/%s.json para adicionar variáveis como {{.sprint}}.",
+	"Editing reminder [%d]. Lines starting with '#' are ignored in the header.": "Editando o lembrete [%d]. Linhas iniciadas com '#' são ignoradas no cabeçalho.",
+	"Error: %s":             "Erro: %s",
+	"Filter: ":              "Filtro: ",
+	"Found: %d reminder(s)": "Encontrado(s): %d lembrete(s)",
+	"Hidden until":          "Oculto até",
+	"Hidden until: %s":      "Oculto até: %s",
+	"Hide until (%s, optional, press Enter to skip)": "Ocultar até (%s, opcional, pressione Enter para pular)",
+	"ID: %d":                               "ID: %d",
+	"Is this reminder recurrent?":          "Este lembrete é recorrente?",
+	"Links":                                "Links",
+	"Links:":                               "Links:",
+	"Next occurrences:":                    "Próximas ocorrências:",
+	"Next":                                 "Próximas",
+	"No changes made.":                     "Nenhuma alteração feita.",
+	"No due reminders found.":              "Nenhum lembrete pendente encontrado.",
+	"No matching reminders found.":         "Nenhum lembrete correspondente encontrado.",
+	"No reminders match %q.":               "Nenhum lembrete corresponde a %q.",
+	"No reminders selected.":               "Nenhum lembrete selecionado.",
+	"No reminders. Press a to add one.":    "Nenhum lembrete. Pressione a para adicionar um.",
+	"No tags found.":                       "Nenhuma tag encontrada.",
+	"No templates found.":                  "Nenhum modelo encontrado.",
+	"No unblocked reminders found.":        "Nenhum lembrete desbloqueado encontrado.",
+	"No":                                   "Não",
+	"Notes go after the first blank line.": "As notas vêm depois da primeira linha em branco.",
+	"Notes:":                               "Notas:",
+	"Opening %s":                           "Abrindo %s",
+	"Priority":                             "Prioridade",
+	"Priority: %s":                         "Prioridade: %s",
+	"Project (optional, press Enter to skip)": "Projeto (opcional, pressione Enter para pular)",
+	"Project":                           "Projeto",
+	"Project: %s":                       "Projeto: %s",
+	"Recurrence type":                   "Tipo de recorrência",
+	"Recurrence":                        "Recorrência",
+	"Recurrent":                         "Recorrente",
+	"Recurrent: %s":                     "Recorrente: %s",
+	"Reminder [%d] %s deleted":          "Lembrete [%d] %s excluído",
+	"Reminder [%d] %s snoozed until %s": "Lembrete [%d] %s adiado até %s",
+	"Reminder [%d] updated":             "Lembrete [%d] atualizado",
+	"Reminder added successfully!":      "Lembrete adicionado com sucesso!",
+	"Repeats":                           "Repete",
+	"Scheduled start date (%s, optional, press Enter to skip)": "Data de início agendada (%s, opcional, pressione Enter para pular)",
+	"Scheduled":                                  "Agendado",
+	"Scheduled: %s":                              "Agendado: %s",
+	"Select days (multi-select)":                 "Selecione os dias (seleção múltipla)",
+	"Select reminders to check":                  "Selecione os lembretes para concluir",
+	"Shell integration already configured!":      "Integração com o shell já configurada!",
+	"Shell integration configured successfully!": "Integração com o shell configurada com sucesso!",
+	"Snooze for: ":                               "Adiar por: ",
+	"Start date (%s)":                            "Data de início (%s)",
+	"Status":                                     "Situação",
+	"Subtask %d added to [%d] %s":                "Subtarefa %d adicionada a [%d] %s",
+	"Subtasks (%d/%d):":                          "Subtarefas (%d/%d):",
+	"Subtasks":                                   "Subtarefas",
+	"Tags (e.g. #ops #billing, optional, press Enter to skip)": "Tags (ex.: #ops #billing, opcional, pressione Enter para pular)",
+	"Tags":                           "Tags",
+	"Tags: %s":                       "Tags: %s",
+	"Template %q deleted":            "Modelo %q excluído",
+	"Template %q saved from [%d] %s": "Modelo %q salvo a partir de [%d] %s",
+	"The urgent_reminder_list function has been added to your shell config.": "A função urgent_reminder_list foi adicionada à configuração do seu shell.",
+	"The urgent_reminder_list function is already in your shell config.":     "A função urgent_reminder_list já está na configuração do seu shell.",
+	"Themes directory: %s": "Diretório de temas: %s",
+	"This will automatically run 'urgent-reminder list%s' in new terminals.": "Isso executará 'urgent-reminder list%s' automaticamente em novos terminais.",
+	"Time (HH:MM, optional, press Enter to skip)":                            "Hora (HH:MM, opcional, pressione Enter para pular)",
+	"Time":      "Hora",
+	"Time: %s":  "Hora: %s",
+	"Title":     "Título",
+	"Title: ":   "Título: ",
+	"Title: %s": "Título: %s",
+	"To apply changes (if you just set this up manually):": "Para aplicar as alterações (se você acabou de configurar manualmente):",
+	"To apply changes:":                           "Para aplicar as alterações:",
+	"To change data location, set XDG_DATA_HOME:": "Para mudar o local dos dados, defina XDG_DATA_HOME:",
+	"Total: %d URGENT REMINDER(S)":                "Total: %d LEMBRETE(S) URGENTE(S)",
+	"Total: %d URGENT REMINDER(S), %d upcoming":   "Total: %d LEMBRETE(S) URGENTE(S), %d próximo(s)",
+	"Total: %d reminder(s) in %s":                 "Total: %d lembrete(s) em %s",
+	"Total: %d reminder(s) this week":             "Total: %d lembrete(s) nesta semana",
+	"Upcoming:":                                   "Próximos:",
+	"Urgency":                                     "Urgência",
+	"Use one \"Link:\" line per URL or file path and one \"Field: name=value\" line per custom field.": "Use uma linha \"Link:\" por URL ou caminho de arquivo e uma linha \"Field: nome=valor\" por campo personalizado.",
+	"Warn before (e.g. 7d,1d,2h, optional, press Enter to skip)":                                       "Avisar antes (ex.: 7d,1d,2h, opcional, pressione Enter para pular)",
+	"Warn before":                   "Avisar antes",
+	"Warn before: %s":               "Avisar antes: %s",
+	"Warning: [%d] %s is blocking:": "Atenção: [%d] %s está bloqueando:",
+	"When":                          "Quando",
+	"Overdue by":                    "Atrasado há",
+	"Yes":                           "Sim",
+	"[%d] %s added, press e to fill in the details":     "[%d] %s adicionado, pressione e para preencher os detalhes",
+	"[%d] %s completed":                                 "[%d] %s concluído",
+	"[%d] %s deleted":                                   "[%d] %s excluído",
+	"[%d] %s is blocked by [%d] %s":                     "[%d] %s está bloqueado por [%d] %s",
+	"[%d] %s is no longer blocked by [%d] %s":           "[%d] %s não está mais bloqueado por [%d] %s",
+	"[%d] %s is no longer blocked":                      "[%d] %s não está mais bloqueado",
+	"[%d] %s snoozed until %s":                          "[%d] %s adiado até %s",
+	"[%d] %s, next due %s":                              "[%d] %s, próximo vencimento %s",
+	"[%d] %s: %d/%d subtasks done":                      "[%d] %s: %d/%d subtarefas concluídas",
+	"[%d] %s: advanced to next cycle, next due date %s": "[%d] %s: avançou para o próximo ciclo, próximo vencimento %s",
+	"[%d] %s: completed and deleted":                    "[%d] %s: concluído e excluído",
+	"all day":                                           "dia todo",
+	"does not repeat":                                   "não se repete",
+	"due now":                                           "vence agora",
+	"due today":                                         "vence hoje",
+	"enter a number between 1 and 31":                   "digite um número entre 1 e 31",
+	"enter to confirm, esc to cancel":                   "enter para confirmar, esc para cancelar",
+	"every %s":                                          "toda %s",
+	"every month on day %d":                             "todo mês no dia %d",
+	"every other %s":                                    "%s, semana sim, semana não",
+	"every other week":                                  "a cada duas semanas",
+	"every week":                                        "toda semana",
+	"every year":                                        "todo ano",
+	"filter: %q (esc to clear) · ":                      "filtro: %q (esc para limpar) · ",
+	"in %s":                                             "em %s",
+	"invalid date format, use %s":                       "formato de data inválido, use %s",
+	"invalid month %q, use YYYY-MM, 1-12 or a month name": "mês inválido %q, use YYYY-MM, 1-12 ou o nome do mês",
+	"invalid weekday %q": "dia da semana inválido %q",
+	"j/k move · c check · s snooze · e edit · d delete · a add · / filter · r reload · q quit": "j/k mover · c concluir · s adiar · e editar · d excluir · a adicionar · / filtrar · r recarregar · q sair",
+	"no":                                  "não",
+	"nothing scheduled":                   "nada agendado",
+	"overdue %s":                          "atrasado %s",
+	"tier %q command failed for [%d]: %v": "o comando do nível %q falhou para [%d]: %v",
+	"title cannot be empty":               "o título não pode ficar vazio",
+	"title or notes":                      "título ou notas",
+	"yes":                                 "sim",
+	"overdue":                             "atrasado",
+	"due":                                 "pendente",
+	"upcoming":                            "próximo",
+	"critical":                            "crítico",
+	"low":                                 "baixa",
+	"normal":                              "normal",
+	"high":                                "alta",
+	"weekly":                              "semanal",
+	"bi-weekly":                           "quinzenal",
+	"monthly":                             "mensal",
+	"Overdue":                             "Atrasados",
+	"Today":                               "Hoje",
+	"Upcoming":                            "Próximos",
+	"failed to initialize storage: %w":    "falha ao inicializar o armazenamento: %w",
+	"prompt failed: %w":                   "falha na pergunta: %w",
+	"failed to get next ID: %w":           "falha ao obter o próximo ID: %w",
+	"invalid time format, use HH:MM":      "formato de hora inválido, use HH:MM",
+	"failed to add reminder: %w":          "falha ao adicionar o lembrete: %w",
+	"failed to initialize template storage: %w":                            "falha ao inicializar o armazenamento de modelos: %w",
+	"invalid variable %q, use name=value":                                  "variável inválida %q, use nome=valor",
+	"invalid date format, use YYYY-MM-DD":                                  "formato de data inválido, use YYYY-MM-DD",
+	"template %q: %w":                                                      "modelo %q: %w",
+	"at least one blocker is required, use --by":                           "é necessário pelo menos um bloqueador, use --by",
+	"failed to get reminder: %w":                                           "falha ao obter o lembrete: %w",
+	"failed to get blocker: %w":                                            "falha ao obter o bloqueador: %w",
+	"failed to add blocker: %w":                                            "falha ao adicionar o bloqueador: %w",
+	"failed to remove blockers: %w":                                        "falha ao remover os bloqueadores: %w",
+	"failed to remove blocker: %w":                                         "falha ao remover o bloqueador: %w",
+	"failed to load reminders: %w":                                         "falha ao carregar os lembretes: %w",
+	"%d of %d reminder(s) could not be checked":                            "%d de %d lembrete(s) não puderam ser concluídos",
+	"failed to find dependents: %w":                                        "falha ao encontrar os dependentes: %w",
+	"failed to update reminder: %w":                                        "falha ao atualizar o lembrete: %w",
+	"failed to delete reminder: %w":                                        "falha ao excluir o lembrete: %w",
+	"failed to get updated reminder: %w":                                   "falha ao obter o lembrete atualizado: %w",
+	"failed to list reminders: %w":                                         "falha ao listar os lembretes: %w",
+	"range %s spans more than %d IDs":                                      "o intervalo %s abrange mais de %d IDs",
+	"failed to get home directory: %w":                                     "falha ao obter o diretório pessoal: %w",
+	"invalid reminder: %w":                                                 "lembrete inválido: %w",
+	"header line %q is not in \"Key: value\" form":                         "a linha de cabeçalho %q não está no formato \"Key: value\"",
+	"field line %q is not in \"Field: name=value\" form":                   "a linha de campo %q não está no formato \"Field: name=value\"",
+	"unknown header %q":                                                    "cabeçalho desconhecido %q",
+	"editor %q failed: %w":                                                 "o editor %q falhou: %w",
+	"failed to create temporary file: %w":                                  "falha ao criar o arquivo temporário: %w",
+	"failed to write temporary file: %w":                                   "falha ao gravar o arquivo temporário: %w",
+	"failed to read temporary file: %w":                                    "falha ao ler o arquivo temporário: %w",
+	"invalid field filter %q, use name=value":                              "filtro de campo inválido %q, use nome=valor",
+	"--recurrent and --one-off cannot be used together":                    "--recurrent e --one-off não podem ser usados juntos",
+	"reminder [%d] has no links, add one with 'urgent-reminder edit %d'":   "o lembrete [%d] não tem links, adicione um com 'urgent-reminder edit %d'",
+	"failed to open %s: %w":                                                "falha ao abrir %s: %w",
+	"--format and --output cannot be used together":                        "--format e --output não podem ser usados juntos",
+	"--columns cannot be used with --output %s":                            "--columns não pode ser usado com --output %s",
+	"invalid prompt format: %w":                                            "formato de prompt inválido: %w",
+	"failed to render prompt format: %w":                                   "falha ao renderizar o formato do prompt: %w",
+	"unsupported shell %q, use bash, zsh or starship":                      "shell não suportado %q, use bash, zsh ou starship",
+	"failed to summarize reminders: %w":                                    "falha ao resumir os lembretes: %w",
+	"--regex and --fuzzy cannot be used together":                          "--regex e --fuzzy não podem ser usados juntos",
+	"failed to search reminders: %w":                                       "falha ao buscar os lembretes: %w",
+	"failed to read config file: %w":                                       "falha ao ler o arquivo de configuração: %w",
+	"failed to open config file: %w":                                       "falha ao abrir o arquivo de configuração: %w",
+	"failed to write to config file: %w":                                   "falha ao gravar no arquivo de configuração: %w",
+	"failed to snooze reminder: %w":                                        "falha ao adiar o lembrete: %w",
+	"subtask cannot be empty":                                              "a subtarefa não pode ficar vazia",
+	"failed to add subtask: %w":                                            "falha ao adicionar a subtarefa: %w",
+	"invalid subtask number: %s":                                           "número de subtarefa inválido: %s",
+	"failed to update subtask: %w":                                         "falha ao atualizar a subtarefa: %w",
+	"failed to list tags: %w":                                              "falha ao listar as tags: %w",
+	"template %q already exists, use --force to overwrite it":              "o modelo %q já existe, use --force para sobrescrevê-lo",
+	"failed to marshal template: %w":                                       "falha ao serializar o modelo: %w",
+	"failed to run tui: %w":                                                "falha ao executar a tui: %w",
+	"failed to parse config file %s: %w":                                   "falha ao interpretar o arquivo de configuração %s: %w",
+	"invalid config file %s: %w":                                           "arquivo de configuração inválido %s: %w",
+	"format %q cannot be empty":                                            "o formato %q não pode ficar vazio",
+	"invalid field name %q, use lowercase letters, digits, '-' and '_'":    "nome de campo inválido %q, use letras minúsculas, dígitos, '-' e '_'",
+	"field %q must be an integer":                                          "o campo %q deve ser um número inteiro",
+	"field %q must be a date (YYYY-MM-DD)":                                 "o campo %q deve ser uma data (YYYY-MM-DD)",
+	"field %q must be one of: %s":                                          "o campo %q deve ser um destes: %s",
+	"enum field %q needs a list of values":                                 "o campo enum %q precisa de uma lista de valores",
+	"field %q has unknown type %q, use string, int, date or enum":          "o campo %q tem o tipo desconhecido %q, use string, int, date ou enum",
+	"every tier needs a name":                                              "todo nível precisa de um nome",
+	"tier %q: %w":                                                          "nível %q: %w",
+	"invalid column %q, use: %s":                                           "coluna inválida %q, use: %s",
+	"theme %q not found, use one of %s or a file in %s":                    "tema %q não encontrado, use um destes: %s ou um arquivo em %s",
+	"failed to read theme file: %w":                                        "falha ao ler o arquivo de tema: %w",
+	"failed to parse theme file %s: %w":                                    "falha ao interpretar o arquivo de tema %s: %w",
+	"theme %s extends unknown theme %q":                                    "o tema %s estende o tema desconhecido %q",
+	"invalid theme file %s: %w":                                            "arquivo de tema inválido %s: %w",
+	"separator width cannot be negative":                                   "a largura do separador não pode ser negativa",
+	"unknown banner font %q":                                               "fonte de banner desconhecida %q",
+	"invalid priority %q, use low, normal, high or critical":               "prioridade inválida %q, use low, normal, high ou critical",
+	"lead time %q must be positive":                                        "a antecedência %q deve ser positiva",
+	"template %q: invalid %s: %w":                                          "modelo %q: %s inválido: %w",
+	"template %q: %s: %w":                                                  "modelo %q: %s: %w",
+	"template %q: title cannot be empty":                                   "modelo %q: o título não pode ficar vazio",
+	"invalid output format %q, use one of: %s":                             "formato de saída inválido %q, use um destes: %s",
+	"output format %q is not supported here":                               "o formato de saída %q não é suportado aqui",
+	"invalid format %q: %w":                                                "formato inválido %q: %w",
+	"failed to render format: %w":                                          "falha ao renderizar o formato: %w",
+	"a reminder cannot block itself":                                       "um lembrete não pode bloquear a si mesmo",
+	"reminder with ID %d not found":                                        "lembrete com ID %d não encontrado",
+	"reminder %d cannot be blocked by %d: that would create a cycle %s":    "o lembrete %d não pode ser bloqueado por %d: isso criaria um ciclo %s",
+	"invalid sort field %q, use one of: %s":                                "campo de ordenação inválido %q, use um destes: %s",
+	"failed to calculate next due date: %w":                                "falha ao calcular o próximo vencimento: %w",
+	"reminder with ID %d has no subtask %d":                                "o lembrete com ID %d não tem a subtarefa %d",
+	"snooze duration must be positive":                                     "a duração do adiamento deve ser positiva",
+	"invalid regular expression: %w":                                       "expressão regular inválida: %w",
+	"empty reminder reference":                                             "referência de lembrete vazia",
+	"UUID prefix %q matches %d reminders, use more characters":             "o prefixo de UUID %q corresponde a %d lembretes, use mais caracteres",
+	"no reminder matches %q":                                               "nenhum lembrete corresponde a %q",
+	"%q matches %d reminders: %s":                                          "%q corresponde a %d lembretes: %s",
+	"failed to marshal cache: %w":                                          "falha ao serializar o cache: %w",
+	"failed to create cache directory: %w":                                 "falha ao criar o diretório de cache: %w",
+	"failed to write cache file: %w":                                       "falha ao gravar o arquivo de cache: %w",
+	"failed to create data directory: %w":                                  "falha ao criar o diretório de dados: %w",
+	"failed to read reminders file: %w":                                    "falha ao ler o arquivo de lembretes: %w",
+	"failed to migrate old format: %w":                                     "falha ao migrar o formato antigo: %w",
+	"failed to save migrated reminders: %w":                                "falha ao salvar os lembretes migrados: %w",
+	"failed to parse reminders: %w":                                        "falha ao interpretar os lembretes: %w",
+	"failed to marshal reminders: %w":                                      "falha ao serializar os lembretes: %w",
+	"failed to write reminders file: %w":                                   "falha ao gravar o arquivo de lembretes: %w",
+	"template %q not found":                                                "modelo %q não encontrado",
+	"failed to read template: %w":                                          "falha ao ler o modelo: %w",
+	"failed to parse template %q: %w":                                      "falha ao interpretar o modelo %q: %w",
+	"failed to create templates directory: %w":                             "falha ao criar o diretório de modelos: %w",
+	"failed to write template file: %w":                                    "falha ao gravar o arquivo de modelo: %w",
+	"failed to delete template: %w":                                        "falha ao excluir o modelo: %w",
+	"failed to read templates directory: %w":                               "falha ao ler o diretório de modelos: %w",
+	"invalid template name %q, use lowercase letters, digits, '-' and '_'": "nome de modelo inválido %q, use letras minúsculas, dígitos, '-' e '_'",
+	"empty duration":                                                       "duração vazia",
+	"invalid duration %q, use e.g. 2h, 7d or 2w":                           "duração inválida %q, use por exemplo 2h, 7d ou 2w",
+	"invalid duration %q: %w":                                              "duração inválida %q: %w",
+	"invalid duration unit %q in %q":                                       "unidade de duração inválida %q em %q",
+	"invalid date format %q, use YYYY-MM-DD":                               "formato de data inválido %q, use YYYY-MM-DD",
+	"Error:":                                                               "Erro:",
+	"Warning: %v, using the default theme":                                 "Atenção: %v, usando o tema padrão",
+	"unknown color or attribute %q in %q":                                  "cor ou atributo desconhecido %q em %q",
+	"color %s: %w":                                                         "cor %s: %w",
+	"%q only partially matches [%d] %s, use it":                            "%q corresponde só em parte a [%d] %s, usar mesmo assim",
+	"partial title match %q was not confirmed":                             "a correspondência parcial de título %q não foi confirmada",
+	"Warning: %v, using English":                                           "Atenção: %v, usando inglês",
+	"--date and --var can only be used with --template":                    "--date e --var só podem ser usados com --template",
+	"template %q: field %q is not declared in the config file":             "modelo %q: o campo %q não está declarado no arquivo de configuração",
+}
@@ -0,0 +1,153 @@
+package i18n
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const isoDate = "2006-01-02"
+
+type dateNames struct {
+	dateLayout    string
+	weekdays      [7]string
+	weekdaysShort [7]string
+	months        [12]string
+	monthsShort   [12]string
+	monthYear     string
+	dayMonth      string
+}
+
+var englishDates = dateNames{
+	dateLayout:    isoDate,
+	weekdays:      [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	weekdaysShort: [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	months:        [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	monthsShort:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+	monthYear:     "%[1]s %[2]d",
+	dayMonth:      "%[2]s %02[1]d",
+}
+
+var dates = map[Locale]dateNames{
+	English:      englishDates,
+	PortugueseBR: ptBRDates,
+	Spanish:      esDates,
+}
+
+func names() dateNames {
+	if n, ok := dates[current]; ok {
+		return n
+	}
+	return englishDates
+}
+
+func FormatDate(t time.Time) string {
+	return t.Format(names().dateLayout)
+}
+
+func FormatDateTime(t time.Time, at string) string {
+	if at == "" {
+		return FormatDate(t)
+	}
+	return FormatDate(t) + " " + at
+}
+
+func FormatWeekdayDate(t time.Time) string {
+	return WeekdayShort(t.Weekday()) + " " + FormatDate(t)
+}
+
+func FormatMonth(year int, month time.Month) string {
+	return fmt.Sprintf(names().monthYear, MonthName(month), year)
+}
+
+func FormatDayMonth(t time.Time) string {
+	return fmt.Sprintf(names().dayMonth, t.Day(), names().monthsShort[t.Month()-1])
+}
+
+func DateHint() string {
+	layout := names().dateLayout
+	if layout == isoDate {
+		return "YYYY-MM-DD"
+	}
+	return "YYYY-MM-DD, " + strings.NewReplacer("02", "DD", "01", "MM", "2006", "YYYY").Replace(layout)
+}
+
+func ParseDate(input string) (time.Time, error) {
+	input = strings.TrimSpace(input)
+	if date, err := time.Parse(isoDate, input); err == nil {
+		return date, nil
+	}
+	if layout := names().dateLayout; layout != isoDate {
+		if date, err := time.Parse(layout, input); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, Errorf("invalid date format, use %s", DateHint())
+}
+
+func WeekdayName(day time.Weekday) string {
+	return names().weekdays[day]
+}
+
+func WeekdayShort(day time.Weekday) string {
+	return names().weekdaysShort[day]
+}
+
+func MonthName(month time.Month) string {
+	return names().months[month-1]
+}
+
+func ParseWeekday(input string) (time.Weekday, bool) {
+	word := fold(input)
+	if word == "" {
+		return 0, false
+	}
+
+	for _, n := range []dateNames{names(), englishDates} {
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			if word == fold(n.weekdays[day]) || word == fold(n.weekdaysShort[day]) {
+				return day, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func WeekdayMatches(input string, day time.Weekday) bool {
+	word := fold(input)
+	for _, n := range []dateNames{names(), englishDates} {
+		if strings.HasPrefix(fold(n.weekdays[day]), word) {
+			return true
+		}
+	}
+	return false
+}
+
+func ParseMonth(input string) (time.Month, bool) {
+	word := fold(input)
+	if len(word) < 3 {
+		return 0, false
+	}
+
+	for _, n := range []dateNames{names(), englishDates} {
+		for i, name := range n.months {
+			if strings.HasPrefix(fold(name), word) {
+				return time.Month(i + 1), true
+			}
+		}
+	}
+	return 0, false
+}
+
+var accents = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a",
+	"é", "e", "ê", "e",
+	"í", "i",
+	"ó", "o", "ô", "o", "õ", "o",
+	"ú", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+func fold(input string) string {
+	return strings.TrimSuffix(accents.Replace(strings.ToLower(strings.TrimSpace(input))), ".")
+}
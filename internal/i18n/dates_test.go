@@ -0,0 +1,115 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+)
+
+func withLocale(t *testing.T, locale Locale) {
+	previous := Current()
+	SetLocale(locale)
+	t.Cleanup(func() { SetLocale(previous) })
+}
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		locale  Locale
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{locale: English, input: "2026-03-05", want: time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{locale: English, input: "05/03/2026", wantErr: true},
+		{locale: PortugueseBR, input: "05/03/2026", want: time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{locale: PortugueseBR, input: "2026-03-05", want: time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{locale: Spanish, input: " 31/12/2026 ", want: time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{locale: Spanish, input: "12/31/2026", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.locale)+" "+tt.input, func(t *testing.T) {
+			withLocale(t, tt.locale)
+			got, err := ParseDate(tt.input)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseDate(%q) = %v, want error", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error: %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseWeekday(t *testing.T) {
+	tests := []struct {
+		locale Locale
+		input  string
+		want   time.Weekday
+		wantOK bool
+	}{
+		{locale: English, input: "monday", want: time.Monday, wantOK: true},
+		{locale: English, input: "Fri", want: time.Friday, wantOK: true},
+		{locale: English, input: "segunda-feira", wantOK: false},
+		{locale: PortugueseBR, input: "segunda-feira", want: time.Monday, wantOK: true},
+		{locale: PortugueseBR, input: "Sáb.", want: time.Saturday, wantOK: true},
+		{locale: PortugueseBR, input: "tuesday", want: time.Tuesday, wantOK: true},
+		{locale: Spanish, input: "miercoles", want: time.Wednesday, wantOK: true},
+		{locale: Spanish, input: "Mié", want: time.Wednesday, wantOK: true},
+		{locale: Spanish, input: "", wantOK: false},
+		{locale: Spanish, input: "mier", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.locale)+" "+tt.input, func(t *testing.T) {
+			withLocale(t, tt.locale)
+			got, ok := ParseWeekday(tt.input)
+
+			if ok != tt.wantOK {
+				t.Fatalf("ParseWeekday(%q) ok = %v, want %v", tt.input, ok, tt.wantOK)
+			}
+			if ok && got != tt.want {
+				t.Errorf("ParseWeekday(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseMonth(t *testing.T) {
+	tests := []struct {
+		locale Locale
+		input  string
+		want   time.Month
+		wantOK bool
+	}{
+		{locale: English, input: "January", want: time.January, wantOK: true},
+		{locale: English, input: "sep", want: time.September, wantOK: true},
+		{locale: English, input: "ja", wantOK: false},
+		{locale: English, input: "abril", wantOK: false},
+		{locale: PortugueseBR, input: "marco", want: time.March, wantOK: true},
+		{locale: PortugueseBR, input: "fev", want: time.February, wantOK: true},
+		{locale: PortugueseBR, input: "december", want: time.December, wantOK: true},
+		{locale: Spanish, input: "Septiembre", want: time.September, wantOK: true},
+		{locale: Spanish, input: "dic", want: time.December, wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.locale)+" "+tt.input, func(t *testing.T) {
+			withLocale(t, tt.locale)
+			got, ok := ParseMonth(tt.input)
+
+			if ok != tt.wantOK {
+				t.Fatalf("ParseMonth(%q) ok = %v, want %v", tt.input, ok, tt.wantOK)
+			}
+			if ok && got != tt.want {
+				t.Errorf("ParseMonth(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
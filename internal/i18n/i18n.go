@@ -0,0 +1,109 @@
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+type Locale string
+
+const (
+	English      Locale = "en"
+	PortugueseBR Locale = "pt-BR"
+	Spanish      Locale = "es"
+)
+
+var Locales = []Locale{English, PortugueseBR, Spanish}
+
+var catalogs = map[Locale]map[string]string{
+	PortugueseBR: ptBRMessages,
+	Spanish:      esMessages,
+}
+
+var current = English
+
+func SetLocale(locale Locale) {
+	current = locale
+}
+
+func Current() Locale {
+	return current
+}
+
+func In(locale Locale, render func() string) string {
+	previous := current
+	current = locale
+	defer func() { current = previous }()
+	return render()
+}
+
+func ParseLocale(input string) (Locale, bool) {
+	tag := strings.TrimSpace(input)
+	if i := strings.IndexAny(tag, ".@"); i >= 0 {
+		tag = tag[:i]
+	}
+	tag = strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+
+	switch {
+	case tag == "":
+		return "", false
+	case tag == "pt-br" || tag == "pt":
+		return PortugueseBR, true
+	case tag == "es" || strings.HasPrefix(tag, "es-"):
+		return Spanish, true
+	case tag == "en" || strings.HasPrefix(tag, "en-") || tag == "c" || tag == "posix":
+		return English, true
+	default:
+		return "", false
+	}
+}
+
+func Detect(configured string) (Locale, error) {
+	if configured != "" {
+		locale, ok := ParseLocale(configured)
+		if !ok {
+			return English, fmt.Errorf("unsupported locale %q, use one of %s", configured, localeNames())
+		}
+		return locale, nil
+	}
+
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+		if locale, ok := ParseLocale(value); ok {
+			return locale, nil
+		}
+		return English, nil
+	}
+	return English, nil
+}
+
+func T(message string, args ...any) string {
+	message = translate(message)
+	if len(args) == 0 {
+		return message
+	}
+	return fmt.Sprintf(message, args...)
+}
+
+func Errorf(format string, args ...any) error {
+	return fmt.Errorf(translate(format), args...)
+}
+
+func translate(message string) string {
+	if translated, ok := catalogs[current][message]; ok {
+		return translated
+	}
+	return message
+}
+
+func localeNames() string {
+	names := make([]string, len(Locales))
+	for i, locale := range Locales {
+		names[i] = string(locale)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,118 @@
+package i18n
+
+import (
+	"regexp"
+	"slices"
+	"testing"
+)
+
+func TestParseLocale(t *testing.T) {
+	tests := []struct {
+		input  string
+		want   Locale
+		wantOK bool
+	}{
+		{input: "en", want: English, wantOK: true},
+		{input: "en_US.UTF-8", want: English, wantOK: true},
+		{input: "C", want: English, wantOK: true},
+		{input: "POSIX", want: English, wantOK: true},
+		{input: "pt_BR.UTF-8", want: PortugueseBR, wantOK: true},
+		{input: "pt-br", want: PortugueseBR, wantOK: true},
+		{input: "pt", want: PortugueseBR, wantOK: true},
+		{input: "es_MX.UTF-8@euro", want: Spanish, wantOK: true},
+		{input: "es", want: Spanish, wantOK: true},
+		{input: "de_DE.UTF-8", wantOK: false},
+		{input: "pt_PT", wantOK: false},
+		{input: "", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, ok := ParseLocale(tt.input)
+			if ok != tt.wantOK {
+				t.Fatalf("ParseLocale(%q) ok = %v, want %v", tt.input, ok, tt.wantOK)
+			}
+			if got != tt.want {
+				t.Errorf("ParseLocale(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured string
+		env        map[string]string
+		want       Locale
+		wantErr    bool
+	}{
+		{name: "configured wins", configured: "es", env: map[string]string{"LANG": "pt_BR.UTF-8"}, want: Spanish},
+		{name: "unsupported configured", configured: "fr", wantErr: true},
+		{name: "LC_ALL before LANG", env: map[string]string{"LC_ALL": "pt_BR.UTF-8", "LANG": "es_ES.UTF-8"}, want: PortugueseBR},
+		{name: "LC_MESSAGES before LANG", env: map[string]string{"LC_MESSAGES": "es_ES.UTF-8", "LANG": "pt_BR.UTF-8"}, want: Spanish},
+		{name: "unknown env falls back", env: map[string]string{"LANG": "de_DE.UTF-8"}, want: English},
+		{name: "no env", want: English},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+				t.Setenv(name, tt.env[name])
+			}
+
+			got, err := Detect(tt.configured)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Detect(%q) = %q, want error", tt.configured, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Detect(%q) error: %v", tt.configured, err)
+			}
+			if got != tt.want {
+				t.Errorf("Detect(%q) = %q, want %q", tt.configured, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		locale Locale
+		want   string
+	}{
+		{locale: English, want: "No due reminders found."},
+		{locale: PortugueseBR, want: ptBRMessages["No due reminders found."]},
+		{locale: Spanish, want: esMessages["No due reminders found."]},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.locale), func(t *testing.T) {
+			withLocale(t, tt.locale)
+			got := T("No due reminders found.")
+			if got == "" || got != tt.want {
+				t.Errorf("T() in %s = %q, want %q", tt.locale, got, tt.want)
+			}
+		})
+	}
+}
+
+var verbPattern = regexp.MustCompile(`%(\[\d+\])?[-+# 0]*\d*(\.\d+)?[a-zA-Z%]`)
+
+func verbs(message string) []string {
+	found := verbPattern.FindAllString(message, -1)
+	slices.Sort(found)
+	return found
+}
+
+func TestCatalogVerbs(t *testing.T) {
+	for locale, catalog := range catalogs {
+		for message, translation := range catalog {
+			if !slices.Equal(verbs(message), verbs(translation)) {
+				t.Errorf("%s: %q translates to %q with different verbs", locale, message, translation)
+			}
+		}
+	}
+}
@@ -1,7 +1,13 @@
 package models
 
 import (
+	"math"
+	"sort"
+	"strings"
 	"time"
+
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/timeutil"
 )
 
 type RecurrentType string
@@ -13,21 +19,77 @@ const (
 	RecurrentMonthly  RecurrentType = "monthly"
 )
 
+type Priority string
+
+const (
+	PriorityLow      Priority = "low"
+	PriorityNormal   Priority = "normal"
+	PriorityHigh     Priority = "high"
+	PriorityCritical Priority = "critical"
+)
+
+var Priorities = []Priority{PriorityLow, PriorityNormal, PriorityHigh, PriorityCritical}
+
+func ParsePriority(input string) (Priority, error) {
+	value := strings.ToLower(strings.TrimSpace(input))
+	if value == "" {
+		return PriorityNormal, nil
+	}
+	for _, p := range Priorities {
+		if string(p) == value {
+			return p, nil
+		}
+	}
+	return "", i18n.Errorf("invalid priority %q, use low, normal, high or critical", input)
+}
+
+func (p Priority) Weight() float64 {
+	switch p {
+	case PriorityLow:
+		return 0
+	case PriorityHigh:
+		return 5
+	case PriorityCritical:
+		return 9
+	default:
+		return 2
+	}
+}
+
+type Subtask struct {
+	Title string `json:"title"`
+	Done  bool   `json:"done"`
+}
+
 type Reminder struct {
-	ID                  int           `json:"id"`
-	Title               string        `json:"title"`
-	DueDate             time.Time     `json:"due_date"`
-	Time                string        `json:"time,omitempty"`
-	IsRecurrent         bool          `json:"is_recurrent"`
-	RecurrentType       RecurrentType `json:"recurrent_type,omitempty"`
-	RecurrentDays       []string      `json:"recurrent_days,omitempty"`
-	RecurrentDayOfMonth int           `json:"recurrent_day_of_month,omitempty"`
-	CreatedAt           time.Time     `json:"created_at"`
+	ID                  int               `json:"id"`
+	UUID                string            `json:"uuid"`
+	Title               string            `json:"title"`
+	DueDate             time.Time         `json:"due_date"`
+	Time                string            `json:"time,omitempty"`
+	IsRecurrent         bool              `json:"is_recurrent"`
+	RecurrentType       RecurrentType     `json:"recurrent_type,omitempty"`
+	RecurrentDays       []string          `json:"recurrent_days,omitempty"`
+	RecurrentDayOfMonth int               `json:"recurrent_day_of_month,omitempty"`
+	Tags                []string          `json:"tags,omitempty"`
+	Project             string            `json:"project,omitempty"`
+	Priority            Priority          `json:"priority,omitempty"`
+	Notes               string            `json:"notes,omitempty"`
+	Links               []string          `json:"links,omitempty"`
+	Subtasks            []Subtask         `json:"subtasks,omitempty"`
+	BlockedBy           []int             `json:"-"`
+	Blockers            []string          `json:"blockers,omitempty"`
+	WarnBefore          []string          `json:"warn_before,omitempty"`
+	Fields              map[string]string `json:"fields,omitempty"`
+	ScheduledDate       *time.Time        `json:"scheduled_date,omitempty"`
+	WaitUntil           *time.Time        `json:"wait_until,omitempty"`
+	CreatedAt           time.Time         `json:"created_at"`
 }
 
 func NewReminder(id int, title string, dueDate time.Time) *Reminder {
 	return &Reminder{
 		ID:          id,
+		UUID:        NewUUID(),
 		Title:       title,
 		DueDate:     dueDate,
 		IsRecurrent: false,
@@ -38,6 +100,7 @@ func NewReminder(id int, title string, dueDate time.Time) *Reminder {
 func NewRecurrentReminder(id int, title string, dueDate time.Time, recurrentType RecurrentType) *Reminder {
 	return &Reminder{
 		ID:            id,
+		UUID:          NewUUID(),
 		Title:         title,
 		DueDate:       dueDate,
 		IsRecurrent:   true,
@@ -46,29 +109,142 @@ func NewRecurrentReminder(id int, title string, dueDate time.Time, recurrentType
 	}
 }
 
-func (r *Reminder) IsOverdue() bool {
-	now := time.Now()
-	dueDateTime := r.DueDate
-
+func (r *Reminder) DueDateTime() time.Time {
 	if r.Time != "" {
 		parsedTime, _ := time.Parse("15:04", r.Time)
-		dueDateTime = time.Date(r.DueDate.Year(), r.DueDate.Month(), r.DueDate.Day(),
-			parsedTime.Hour(), parsedTime.Minute(), 0, 0, r.DueDate.Location())
+		return time.Date(r.DueDate.Year(), r.DueDate.Month(), r.DueDate.Day(),
+			parsedTime.Hour(), parsedTime.Minute(), 0, 0, time.Local)
 	}
 
-	return now.After(dueDateTime)
+	return time.Date(r.DueDate.Year(), r.DueDate.Month(), r.DueDate.Day(), 0, 0, 0, 0, time.Local)
 }
 
-func (r *Reminder) IsDue() bool {
-	now := time.Now()
-	dueDateTime := r.DueDate
+func (r *Reminder) EffectivePriority() Priority {
+	if r.Priority == "" {
+		return PriorityNormal
+	}
+	return r.Priority
+}
 
-	if r.Time != "" {
-		parsedTime, _ := time.Parse("15:04", r.Time)
-		dueDateTime = time.Date(r.DueDate.Year(), r.DueDate.Month(), r.DueDate.Day(),
-			parsedTime.Hour(), parsedTime.Minute(), 0, 0, r.DueDate.Location())
+func (r *Reminder) Urgency(now time.Time) float64 {
+	score := r.EffectivePriority().Weight()
+	due := r.DueDateTime()
+
+	if !now.Before(due) {
+		daysOverdue := now.Sub(due).Hours() / 24
+		if daysOverdue > 14 {
+			daysOverdue = 14
+		}
+		score += 5 + daysOverdue*0.5
+	} else {
+		daysUntil := due.Sub(now).Hours() / 24
+		if daysUntil < 14 {
+			score += 4 * (1 - daysUntil/14)
+		}
+	}
+
+	return math.Round(score*100) / 100
+}
+
+func (r *Reminder) LeadTimes() []time.Duration {
+	var leads []time.Duration
+	for _, value := range r.WarnBefore {
+		if lead, err := timeutil.ParseDuration(value); err == nil && lead > 0 {
+			leads = append(leads, lead)
+		}
+	}
+	sort.Slice(leads, func(i, j int) bool { return leads[i] > leads[j] })
+	return leads
+}
+
+func (r *Reminder) ActiveWarning(now time.Time) (time.Duration, bool) {
+	due := r.DueDateTime()
+	if !now.Before(due) {
+		return 0, false
 	}
 
+	leads := r.LeadTimes()
+	for i := len(leads) - 1; i >= 0; i-- {
+		if !now.Before(due.Add(-leads[i])) {
+			return leads[i], true
+		}
+	}
+	return 0, false
+}
+
+func (r *Reminder) IsInWarningWindow(now time.Time) bool {
+	_, ok := r.ActiveWarning(now)
+	return ok
+}
+
+func ParseLeadTimes(input string) ([]string, error) {
+	fields := strings.FieldsFunc(input, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+
+	type leadTime struct {
+		value    string
+		duration time.Duration
+	}
+
+	var leads []leadTime
+	for _, field := range fields {
+		duration, err := timeutil.ParseDuration(field)
+		if err != nil {
+			return nil, err
+		}
+		if duration <= 0 {
+			return nil, i18n.Errorf("lead time %q must be positive", field)
+		}
+		leads = append(leads, leadTime{value: strings.ToLower(field), duration: duration})
+	}
+
+	sort.SliceStable(leads, func(i, j int) bool { return leads[i].duration > leads[j].duration })
+
+	var values []string
+	for _, lead := range leads {
+		values = append(values, lead.value)
+	}
+	return values, nil
+}
+
+func (r *Reminder) IsWaiting(now time.Time) bool {
+	return r.WaitUntil != nil && now.Before(localDate(*r.WaitUntil))
+}
+
+func (r *Reminder) HasStarted(now time.Time) bool {
+	return r.ScheduledDate != nil && !now.Before(localDate(*r.ScheduledDate))
+}
+
+func (r *Reminder) FormatScheduledDate() string {
+	if r.ScheduledDate == nil {
+		return ""
+	}
+	return r.ScheduledDate.Format("2006-01-02")
+}
+
+func (r *Reminder) FormatWaitUntil() string {
+	if r.WaitUntil == nil {
+		return ""
+	}
+	return r.WaitUntil.Format("2006-01-02")
+}
+
+func localDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
+
+func (r *Reminder) IsOverdue() bool {
+	if r.Time == "" {
+		return !time.Now().Before(r.DueDateTime().AddDate(0, 0, 1))
+	}
+	return time.Now().After(r.DueDateTime())
+}
+
+func (r *Reminder) IsDue() bool {
+	now := time.Now()
+	dueDateTime := r.DueDateTime()
+
 	return now.After(dueDateTime) || now.Equal(dueDateTime)
 }
 
@@ -82,3 +258,177 @@ func (r *Reminder) FormatTime() string {
 	}
 	return r.Time
 }
+
+func (r *Reminder) DueStatus() string {
+	switch {
+	case r.IsOverdue():
+		return "overdue"
+	case r.IsDue():
+		return "due"
+	default:
+		return "upcoming"
+	}
+}
+
+func (r *Reminder) RelativeDue(now time.Time) string {
+	if r.Time == "" {
+		days := r.daysUntil(now)
+		switch {
+		case days > 0:
+			return i18n.T("in %s", timeutil.FormatDays(days))
+		case days < 0:
+			return i18n.T("overdue %s", timeutil.FormatDays(days))
+		default:
+			return i18n.T("due today")
+		}
+	}
+
+	until := r.DueDateTime().Sub(now).Truncate(time.Minute)
+	switch {
+	case until > 0:
+		return i18n.T("in %s", timeutil.FormatDuration(until))
+	case until < 0:
+		return i18n.T("overdue %s", timeutil.FormatDuration(until))
+	default:
+		return i18n.T("due now")
+	}
+}
+
+func (r *Reminder) OverdueBy(now time.Time) string {
+	if r.Time == "" {
+		if days := r.daysUntil(now); days < 0 {
+			return timeutil.FormatDays(days)
+		}
+		return ""
+	}
+
+	if until := r.DueDateTime().Sub(now).Truncate(time.Minute); until < 0 {
+		return timeutil.FormatDuration(until)
+	}
+	return ""
+}
+
+func (r *Reminder) daysUntil(now time.Time) int {
+	return int(math.Round(localDate(r.DueDateTime()).Sub(localDate(now)).Hours() / 24))
+}
+
+func (r *Reminder) RecurrenceDescription() string {
+	if !r.IsRecurrent {
+		return i18n.T("does not repeat")
+	}
+
+	var days []string
+	for _, day := range r.Weekdays() {
+		days = append(days, i18n.WeekdayShort(day))
+	}
+
+	var description string
+	switch r.RecurrentType {
+	case RecurrentWeekly:
+		description = i18n.T("every week")
+		if len(days) > 0 {
+			description = i18n.T("every %s", joinWords(days))
+		}
+	case RecurrentBiWeekly:
+		description = i18n.T("every other week")
+		if len(days) > 0 {
+			description = i18n.T("every other %s", joinWords(days))
+		}
+	case RecurrentMonthly:
+		description = i18n.T("every month on day %d", r.RecurrentDayOfMonth)
+	default:
+		description = i18n.T("every year")
+	}
+
+	if r.Time != "" {
+		description = i18n.T("%s at %s", description, r.Time)
+	}
+	return description
+}
+
+func joinWords(words []string) string {
+	switch len(words) {
+	case 0:
+		return ""
+	case 1:
+		return words[0]
+	default:
+		return i18n.T("%s and %s", strings.Join(words[:len(words)-1], ", "), words[len(words)-1])
+	}
+}
+
+func (r *Reminder) HasTag(tag string) bool {
+	tag = NormalizeTag(tag)
+	for _, t := range r.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Reminder) FormatTags() string {
+	formatted := make([]string, len(r.Tags))
+	for i, tag := range r.Tags {
+		formatted[i] = "#" + tag
+	}
+	return strings.Join(formatted, " ")
+}
+
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+}
+
+func ParseTags(input string) []string {
+	fields := strings.FieldsFunc(input, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+
+	var tags []string
+	seen := map[string]bool{}
+	for _, field := range fields {
+		tag := NormalizeTag(field)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+func (r *Reminder) SubtaskProgress() (int, int) {
+	done := 0
+	for _, subtask := range r.Subtasks {
+		if subtask.Done {
+			done++
+		}
+	}
+	return done, len(r.Subtasks)
+}
+
+func (r *Reminder) ResetSubtasks() {
+	for i := range r.Subtasks {
+		r.Subtasks[i].Done = false
+	}
+}
+
+func (r *Reminder) SetField(name, value string) {
+	if value == "" {
+		delete(r.Fields, name)
+		return
+	}
+	if r.Fields == nil {
+		r.Fields = map[string]string{}
+	}
+	r.Fields[name] = value
+}
+
+func (r *Reminder) FieldNames() []string {
+	names := make([]string, 0, len(r.Fields))
+	for name := range r.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
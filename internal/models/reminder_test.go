@@ -0,0 +1,100 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRelativeDue(t *testing.T) {
+	now := time.Date(2026, 1, 15, 10, 30, 0, 0, time.Local)
+	day := func(offset int) time.Time {
+		return time.Date(2026, 1, 15+offset, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name string
+		due  time.Time
+		at   string
+		want string
+	}{
+		{name: "all-day today", due: day(0), want: "due today"},
+		{name: "all-day tomorrow", due: day(1), want: "in 1d"},
+		{name: "all-day in weeks", due: day(17), want: "in 2w 3d"},
+		{name: "all-day overdue", due: day(-3), want: "overdue 3d"},
+		{name: "all-day overdue weeks", due: day(-14), want: "overdue 2w"},
+		{name: "timed later today", due: day(0), at: "12:45", want: "in 2h 15m"},
+		{name: "timed now", due: day(0), at: "10:30", want: "due now"},
+		{name: "timed minutes ago", due: day(0), at: "10:05", want: "overdue 25m"},
+		{name: "timed tomorrow", due: day(1), at: "14:30", want: "in 1d 4h"},
+		{name: "timed overdue days", due: day(-2), at: "10:30", want: "overdue 2d"},
+		{name: "timed in weeks truncates", due: day(10), at: "09:00", want: "in 1w 2d"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reminder := &Reminder{DueDate: tt.due, Time: tt.at}
+			if got := reminder.RelativeDue(now); got != tt.want {
+				t.Errorf("RelativeDue() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOverdueBy(t *testing.T) {
+	now := time.Date(2026, 1, 15, 10, 30, 0, 0, time.Local)
+	day := func(offset int) time.Time {
+		return time.Date(2026, 1, 15+offset, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name string
+		due  time.Time
+		at   string
+		want string
+	}{
+		{name: "all-day today", due: day(0), want: ""},
+		{name: "all-day yesterday", due: day(-1), want: "1d"},
+		{name: "all-day weeks ago", due: day(-17), want: "2w 3d"},
+		{name: "timed later today", due: day(0), at: "12:45", want: ""},
+		{name: "timed minutes ago", due: day(0), at: "10:05", want: "25m"},
+		{name: "timed days ago", due: day(-2), at: "08:00", want: "2d 2h"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reminder := &Reminder{DueDate: tt.due, Time: tt.at}
+			if got := reminder.OverdueBy(now); got != tt.want {
+				t.Errorf("OverdueBy() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDueStatus(t *testing.T) {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name string
+		due  time.Time
+		at   string
+		want string
+	}{
+		{name: "all-day yesterday", due: today.AddDate(0, 0, -1), want: "overdue"},
+		{name: "all-day today", due: today, want: "due"},
+		{name: "all-day tomorrow", due: today.AddDate(0, 0, 1), want: "upcoming"},
+		{name: "timed past", due: time.Date(past.Year(), past.Month(), past.Day(), 0, 0, 0, 0, time.UTC), at: past.Format("15:04"), want: "overdue"},
+		{name: "timed future", due: time.Date(future.Year(), future.Month(), future.Day(), 0, 0, 0, 0, time.UTC), at: future.Format("15:04"), want: "upcoming"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reminder := &Reminder{DueDate: tt.due, Time: tt.at}
+			if got := reminder.DueStatus(); got != tt.want {
+				t.Errorf("DueStatus() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package models
+
+import (
+	"strings"
+	"text/template"
+	"time"
+
+	"urgent-reminder/internal/i18n"
+)
+
+type Template struct {
+	Name                string            `json:"-"`
+	Title               string            `json:"title"`
+	Time                string            `json:"time,omitempty"`
+	DueIn               string            `json:"due_in,omitempty"`
+	RecurrentType       RecurrentType     `json:"recurrent_type,omitempty"`
+	RecurrentDays       []string          `json:"recurrent_days,omitempty"`
+	RecurrentDayOfMonth int               `json:"recurrent_day_of_month,omitempty"`
+	Tags                []string          `json:"tags,omitempty"`
+	Project             string            `json:"project,omitempty"`
+	Priority            Priority          `json:"priority,omitempty"`
+	Notes               string            `json:"notes,omitempty"`
+	Links               []string          `json:"links,omitempty"`
+	Subtasks            []string          `json:"subtasks,omitempty"`
+	WarnBefore          []string          `json:"warn_before,omitempty"`
+	Fields              map[string]string `json:"fields,omitempty"`
+}
+
+func NewTemplateFromReminder(name string, r *Reminder) *Template {
+	t := &Template{
+		Name:       name,
+		Title:      r.Title,
+		Time:       r.Time,
+		Tags:       append([]string(nil), r.Tags...),
+		Project:    r.Project,
+		Priority:   r.Priority,
+		Notes:      r.Notes,
+		Links:      append([]string(nil), r.Links...),
+		WarnBefore: append([]string(nil), r.WarnBefore...),
+	}
+
+	if r.IsRecurrent {
+		t.RecurrentType = r.RecurrentType
+		t.RecurrentDays = append([]string(nil), r.RecurrentDays...)
+		t.RecurrentDayOfMonth = r.RecurrentDayOfMonth
+	}
+
+	for _, subtask := range r.Subtasks {
+		t.Subtasks = append(t.Subtasks, subtask.Title)
+	}
+
+	if len(r.Fields) > 0 {
+		t.Fields = map[string]string{}
+		for name, value := range r.Fields {
+			t.Fields[name] = value
+		}
+	}
+
+	return t
+}
+
+func (t *Template) IsRecurrent() bool {
+	return t.RecurrentType != "" && t.RecurrentType != RecurrentNone
+}
+
+func (t *Template) Instantiate(id int, dueDate time.Time, vars map[string]string) (*Reminder, error) {
+	render := func(field, text string) (string, error) {
+		if !strings.Contains(text, "{{") {
+			return text, nil
+		}
+		tmpl, err := template.New(field).Option("missingkey=error").Parse(text)
+		if err != nil {
+			return "", i18n.Errorf("template %q: invalid %s: %w", t.Name, field, err)
+		}
+		var b strings.Builder
+		if err := tmpl.Execute(&b, vars); err != nil {
+			return "", i18n.Errorf("template %q: %s: %w", t.Name, field, err)
+		}
+		return b.String(), nil
+	}
+
+	title, err := render("title", t.Title)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(title) == "" {
+		return nil, i18n.Errorf("template %q: title cannot be empty", t.Name)
+	}
+
+	var r *Reminder
+	if t.IsRecurrent() {
+		r = NewRecurrentReminder(id, title, dueDate, t.RecurrentType)
+		days, err := ParseWeekdays(t.RecurrentDays)
+		if err != nil {
+			return nil, i18n.Errorf("template %q: %w", t.Name, err)
+		}
+		r.RecurrentDays = days
+		r.RecurrentDayOfMonth = t.RecurrentDayOfMonth
+	} else {
+		r = NewReminder(id, title, dueDate)
+	}
+
+	r.Time = t.Time
+	r.Priority = t.Priority
+	r.WarnBefore = append([]string(nil), t.WarnBefore...)
+
+	if r.Project, err = render("project", t.Project); err != nil {
+		return nil, err
+	}
+	if r.Notes, err = render("notes", t.Notes); err != nil {
+		return nil, err
+	}
+
+	for _, tag := range t.Tags {
+		rendered, err := render("tags", tag)
+		if err != nil {
+			return nil, err
+		}
+		r.Tags = append(r.Tags, ParseTags(rendered)...)
+	}
+
+	for _, link := range t.Links {
+		rendered, err := render("links", link)
+		if err != nil {
+			return nil, err
+		}
+		r.Links = append(r.Links, rendered)
+	}
+
+	for _, subtask := range t.Subtasks {
+		rendered, err := render("subtasks", subtask)
+		if err != nil {
+			return nil, err
+		}
+		r.Subtasks = append(r.Subtasks, Subtask{Title: rendered})
+	}
+
+	for name, value := range t.Fields {
+		rendered, err := render("field "+name, value)
+		if err != nil {
+			return nil, err
+		}
+		r.SetField(name, rendered)
+	}
+
+	return r, nil
+}
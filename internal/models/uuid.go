@@ -0,0 +1,37 @@
+package models
+
+import (
+	"crypto/rand"
+	"crypto/sha1"
+	"fmt"
+	"time"
+)
+
+func NewUUID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(fmt.Sprintf("failed to generate UUID: %v", err))
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+func LegacyUUID(r *Reminder) string {
+	sum := sha1.Sum([]byte(fmt.Sprintf("urgent-reminder:%d:%s:%s", r.ID, r.CreatedAt.UTC().Format(time.RFC3339Nano), r.Title)))
+	b := sum[:16]
+
+	b[6] = (b[6] & 0x0f) | 0x50
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+func (r *Reminder) ShortUUID() string {
+	if len(r.UUID) < 8 {
+		return r.UUID
+	}
+	return r.UUID[:8]
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"urgent-reminder/internal/i18n"
+)
+
+var Weekdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday}
+
+func WeekdayCode(day time.Weekday) string {
+	return day.String()[:3]
+}
+
+func ParseWeekday(input string) (string, error) {
+	day, ok := i18n.ParseWeekday(input)
+	if !ok {
+		return "", i18n.Errorf("invalid weekday %q", input)
+	}
+	return WeekdayCode(day), nil
+}
+
+func ParseWeekdays(days []string) ([]string, error) {
+	codes := make([]string, 0, len(days))
+	for _, day := range days {
+		code, err := ParseWeekday(day)
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+	}
+	return codes, nil
+}
+
+func (r *Reminder) Weekdays() []time.Weekday {
+	var weekdays []time.Weekday
+	for _, code := range r.RecurrentDays {
+		if wd, ok := weekdayFromCode(code); ok {
+			weekdays = append(weekdays, wd)
+		}
+	}
+	return weekdays
+}
+
+func weekdayFromCode(code string) (time.Weekday, bool) {
+	for _, day := range Weekdays {
+		if WeekdayCode(day) == code {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
+func (r *Reminder) FormatDays() string {
+	names := make([]string, 0, len(r.RecurrentDays))
+	for _, day := range r.Weekdays() {
+		names = append(names, i18n.WeekdayShort(day))
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,126 @@
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"urgent-reminder/internal/i18n"
+)
+
+type Format string
+
+const (
+	FormatText   Format = "text"
+	FormatTable  Format = "table"
+	FormatJSON   Format = "json"
+	FormatYAML   Format = "yaml"
+	FormatCSV    Format = "csv"
+	FormatTSV    Format = "tsv"
+	FormatNDJSON Format = "ndjson"
+)
+
+var Formats = []Format{FormatText, FormatTable, FormatJSON, FormatYAML, FormatCSV, FormatTSV, FormatNDJSON}
+
+func ParseFormat(input string) (Format, error) {
+	value := strings.ToLower(strings.TrimSpace(input))
+	if value == "" {
+		return FormatText, nil
+	}
+	for _, format := range Formats {
+		if string(format) == value {
+			return format, nil
+		}
+	}
+
+	names := make([]string, len(Formats))
+	for i, format := range Formats {
+		names[i] = string(format)
+	}
+	return "", i18n.Errorf("invalid output format %q, use one of: %s", input, strings.Join(names, ", "))
+}
+
+type Record interface {
+	Columns() []string
+	Values() []string
+}
+
+func WriteList[T Record](w io.Writer, format Format, records []T) error {
+	if records == nil {
+		records = []T{}
+	}
+
+	switch format {
+	case FormatJSON:
+		return writeJSON(w, records)
+	case FormatYAML:
+		return writeYAML(w, records)
+	case FormatNDJSON:
+		encoder := json.NewEncoder(w)
+		for _, record := range records {
+			if err := encoder.Encode(record); err != nil {
+				return err
+			}
+		}
+		return nil
+	case FormatCSV, FormatTSV:
+		return writeDelimited(w, format, records)
+	default:
+		return i18n.Errorf("output format %q is not supported here", format)
+	}
+}
+
+func WriteOne[T Record](w io.Writer, format Format, record T) error {
+	switch format {
+	case FormatJSON:
+		return writeJSON(w, record)
+	case FormatYAML:
+		return writeYAML(w, record)
+	default:
+		return WriteList(w, format, []T{record})
+	}
+}
+
+func writeJSON(w io.Writer, value any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
+
+func writeYAML(w io.Writer, value any) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(value); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+func writeDelimited[T Record](w io.Writer, format Format, records []T) error {
+	var zero T
+	writer := csv.NewWriter(w)
+	if format == FormatTSV {
+		writer.Comma = '\t'
+	}
+
+	if err := writer.Write(zero.Columns()); err != nil {
+		return err
+	}
+	for _, record := range records {
+		values := record.Values()
+		if format == FormatTSV {
+			for i, value := range values {
+				values[i] = strings.NewReplacer("\t", " ", "\n", " ").Replace(value)
+			}
+		}
+		if err := writer.Write(values); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,203 @@
+package output
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"urgent-reminder/internal/models"
+)
+
+const timestampLayout = time.RFC3339
+
+type Subtask struct {
+	Title string `json:"title" yaml:"title"`
+	Done  bool   `json:"done" yaml:"done"`
+}
+
+type Reminder struct {
+	ID                  int               `json:"id" yaml:"id"`
+	UUID                string            `json:"uuid" yaml:"uuid"`
+	Title               string            `json:"title" yaml:"title"`
+	Section             string            `json:"section,omitempty" yaml:"section,omitempty"`
+	DueDate             string            `json:"due_date" yaml:"due_date"`
+	Time                string            `json:"time,omitempty" yaml:"time,omitempty"`
+	DueAt               string            `json:"due_at" yaml:"due_at"`
+	Status              string            `json:"status" yaml:"status"`
+	IsDue               bool              `json:"is_due" yaml:"is_due"`
+	IsOverdue           bool              `json:"is_overdue" yaml:"is_overdue"`
+	Priority            models.Priority   `json:"priority" yaml:"priority"`
+	Urgency             float64           `json:"urgency" yaml:"urgency"`
+	Recurrent           bool              `json:"recurrent" yaml:"recurrent"`
+	RecurrentType       string            `json:"recurrent_type,omitempty" yaml:"recurrent_type,omitempty"`
+	RecurrentDays       []string          `json:"recurrent_days,omitempty" yaml:"recurrent_days,omitempty"`
+	RecurrentDayOfMonth int               `json:"recurrent_day_of_month,omitempty" yaml:"recurrent_day_of_month,omitempty"`
+	Repeats             string            `json:"repeats" yaml:"repeats"`
+	NextOccurrence      string            `json:"next_occurrence,omitempty" yaml:"next_occurrence,omitempty"`
+	Project             string            `json:"project,omitempty" yaml:"project,omitempty"`
+	Tags                []string          `json:"tags" yaml:"tags"`
+	Fields              map[string]string `json:"fields,omitempty" yaml:"fields,omitempty"`
+	Notes               string            `json:"notes,omitempty" yaml:"notes,omitempty"`
+	Links               []string          `json:"links,omitempty" yaml:"links,omitempty"`
+	Subtasks            []Subtask         `json:"subtasks,omitempty" yaml:"subtasks,omitempty"`
+	Blocked             bool              `json:"blocked" yaml:"blocked"`
+	BlockedBy           []int             `json:"blocked_by,omitempty" yaml:"blocked_by,omitempty"`
+	WarnBefore          []string          `json:"warn_before,omitempty" yaml:"warn_before,omitempty"`
+	ScheduledDate       string            `json:"scheduled_date,omitempty" yaml:"scheduled_date,omitempty"`
+	WaitUntil           string            `json:"wait_until,omitempty" yaml:"wait_until,omitempty"`
+	CreatedAt           string            `json:"created_at" yaml:"created_at"`
+}
+
+func NewReminder(r *models.Reminder, section string, blocked bool, next []time.Time, now time.Time) Reminder {
+	view := Reminder{
+		ID:                  r.ID,
+		UUID:                r.UUID,
+		Title:               r.Title,
+		Section:             section,
+		DueDate:             r.FormatDueDate(),
+		Time:                r.Time,
+		DueAt:               r.DueDateTime().Format(timestampLayout),
+		Status:              r.DueStatus(),
+		IsDue:               r.IsDue(),
+		IsOverdue:           r.IsOverdue(),
+		Priority:            r.EffectivePriority(),
+		Urgency:             r.Urgency(now),
+		Recurrent:           r.IsRecurrent,
+		RecurrentDays:       r.RecurrentDays,
+		RecurrentDayOfMonth: r.RecurrentDayOfMonth,
+		Repeats:             r.RecurrenceDescription(),
+		Project:             r.Project,
+		Tags:                r.Tags,
+		Fields:              r.Fields,
+		Notes:               r.Notes,
+		Links:               r.Links,
+		Blocked:             blocked,
+		BlockedBy:           r.BlockedBy,
+		WarnBefore:          r.WarnBefore,
+		CreatedAt:           r.CreatedAt.Local().Format(timestampLayout),
+	}
+
+	if r.IsRecurrent {
+		view.RecurrentType = string(r.RecurrentType)
+	}
+	if len(next) > 0 {
+		view.NextOccurrence = next[0].Format("2006-01-02")
+	}
+	if view.Tags == nil {
+		view.Tags = []string{}
+	}
+	for _, subtask := range r.Subtasks {
+		view.Subtasks = append(view.Subtasks, Subtask{Title: subtask.Title, Done: subtask.Done})
+	}
+	if r.ScheduledDate != nil {
+		view.ScheduledDate = r.FormatScheduledDate()
+	}
+	if r.WaitUntil != nil {
+		view.WaitUntil = r.FormatWaitUntil()
+	}
+
+	return view
+}
+
+func (Reminder) Columns() []string {
+	return []string{
+		"id", "uuid", "title", "section", "due_date", "time", "status", "is_due", "is_overdue", "priority", "urgency",
+		"repeats", "next_occurrence", "project", "tags", "fields", "subtasks", "blocked_by", "scheduled_date", "wait_until", "created_at",
+	}
+}
+
+func (r Reminder) Values() []string {
+	done := 0
+	for _, subtask := range r.Subtasks {
+		if subtask.Done {
+			done++
+		}
+	}
+	subtasks := ""
+	if len(r.Subtasks) > 0 {
+		subtasks = strconv.Itoa(done) + "/" + strconv.Itoa(len(r.Subtasks))
+	}
+
+	blockedBy := make([]string, len(r.BlockedBy))
+	for i, id := range r.BlockedBy {
+		blockedBy[i] = strconv.Itoa(id)
+	}
+
+	return []string{
+		strconv.Itoa(r.ID),
+		r.UUID,
+		r.Title,
+		r.Section,
+		r.DueDate,
+		r.Time,
+		r.Status,
+		strconv.FormatBool(r.IsDue),
+		strconv.FormatBool(r.IsOverdue),
+		string(r.Priority),
+		strconv.FormatFloat(r.Urgency, 'f', 2, 64),
+		r.Repeats,
+		r.NextOccurrence,
+		r.Project,
+		strings.Join(r.Tags, ";"),
+		formatFields(r.Fields),
+		subtasks,
+		strings.Join(blockedBy, ";"),
+		r.ScheduledDate,
+		r.WaitUntil,
+		r.CreatedAt,
+	}
+}
+
+func formatFields(fields map[string]string) string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pairs := make([]string, len(names))
+	for i, name := range names {
+		pairs[i] = name + "=" + fields[name]
+	}
+	return strings.Join(pairs, ";")
+}
+
+type Occurrence struct {
+	Date     string `json:"date" yaml:"date"`
+	Reminder `yaml:",inline"`
+}
+
+func (Occurrence) Columns() []string {
+	return append([]string{"date"}, Reminder{}.Columns()...)
+}
+
+func (o Occurrence) Values() []string {
+	return append([]string{o.Date}, o.Reminder.Values()...)
+}
+
+type TagCount struct {
+	Tag   string `json:"tag" yaml:"tag"`
+	Count int    `json:"count" yaml:"count"`
+}
+
+func (TagCount) Columns() []string {
+	return []string{"tag", "count"}
+}
+
+func (t TagCount) Values() []string {
+	return []string{t.Tag, strconv.Itoa(t.Count)}
+}
+
+type Template struct {
+	Name  string `json:"name" yaml:"name"`
+	Title string `json:"title" yaml:"title"`
+}
+
+func (Template) Columns() []string {
+	return []string{"name", "title"}
+}
+
+func (t Template) Values() []string {
+	return []string{t.Name, t.Title}
+}
@@ -0,0 +1,115 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+	"unicode/utf8"
+
+	"urgent-reminder/internal/display"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/models"
+)
+
+type ReminderView struct {
+	*models.Reminder
+	Section string
+	Blocked bool
+	now     time.Time
+}
+
+func NewReminderView(r *models.Reminder, section string, blocked bool, now time.Time) ReminderView {
+	return ReminderView{Reminder: r, Section: section, Blocked: blocked, now: now}
+}
+
+func (v ReminderView) Due() string {
+	if v.Time == "" {
+		return v.FormatDueDate()
+	}
+	return v.FormatDueDate() + " " + v.Time
+}
+
+func (v ReminderView) DueIn() string {
+	return v.RelativeDue(v.now)
+}
+
+func (v ReminderView) Status() string {
+	return v.DueStatus()
+}
+
+func (v ReminderView) Priority() models.Priority {
+	return v.EffectivePriority()
+}
+
+func (v ReminderView) Urgency() string {
+	return fmt.Sprintf("%.2f", v.Reminder.Urgency(v.now))
+}
+
+func (v ReminderView) Repeats() string {
+	return v.RecurrenceDescription()
+}
+
+func (v ReminderView) Progress() string {
+	done, total := v.SubtaskProgress()
+	if total == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d", done, total)
+}
+
+func (v ReminderView) Field(name string) string {
+	return v.Fields[strings.ToLower(name)]
+}
+
+var templateFuncs = template.FuncMap{
+	"color": func(spec string, value any) string {
+		return display.ParseColor(spec).Sprint(value)
+	},
+	"join": func(sep string, values []string) string {
+		return strings.Join(values, sep)
+	},
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"pad": func(width int, value any) string {
+		return fmt.Sprintf("%-*s", width, fmt.Sprint(value))
+	},
+	"trunc": func(width int, value any) string {
+		text := fmt.Sprint(value)
+		if width <= 0 || utf8.RuneCountInString(text) <= width {
+			return text
+		}
+		return string([]rune(text)[:width-1]) + "…"
+	},
+}
+
+func ParseTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Funcs(templateFuncs).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, i18n.Errorf("invalid format %q: %w", name, err)
+	}
+	return tmpl, nil
+}
+
+func WriteTemplate(w io.Writer, tmpl *template.Template, views []ReminderView) error {
+	for _, view := range views {
+		var b strings.Builder
+		if err := tmpl.Execute(&b, view); err != nil {
+			return i18n.Errorf("failed to render format: %w", err)
+		}
+
+		line := b.String()
+		if !strings.HasSuffix(line, "\n") {
+			line += "\n"
+		}
+		if _, err := io.WriteString(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func UnescapeFormat(text string) string {
+	return strings.NewReplacer(`\t`, "\t", `\n`, "\n", `\\`, `\`).Replace(text)
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	"urgent-reminder/internal/models"
+)
+
+type Occurrence struct {
+	Reminder *models.Reminder
+	Date     time.Time
+}
+
+func (s *ReminderService) OccurrencesBetween(from, to time.Time, q ReminderQuery) ([]Occurrence, error) {
+	reminders, err := s.store.LoadReminders()
+	if err != nil {
+		return nil, err
+	}
+
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.Local)
+	to = time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.Local)
+	inRange := func(day time.Time) bool {
+		return !day.Before(from) && !day.After(to)
+	}
+
+	var occurrences []Occurrence
+	for _, reminder := range reminders {
+		if !q.MatchesLabels(reminder) {
+			continue
+		}
+
+		due := reminder.DueDateTime()
+		day := time.Date(due.Year(), due.Month(), due.Day(), 0, 0, 0, 0, time.Local)
+		if inRange(day) {
+			occurrences = append(occurrences, Occurrence{Reminder: reminder, Date: day})
+		}
+
+		if !reminder.IsRecurrent {
+			continue
+		}
+		for next := s.nextOccurrenceAfter(reminder, day); !next.After(to); next = s.nextOccurrenceAfter(reminder, next) {
+			if inRange(next) {
+				occurrences = append(occurrences, Occurrence{Reminder: reminder, Date: next})
+			}
+		}
+	}
+
+	sort.SliceStable(occurrences, func(i, j int) bool {
+		a, b := occurrences[i], occurrences[j]
+		if !a.Date.Equal(b.Date) {
+			return a.Date.Before(b.Date)
+		}
+		if a.Reminder.Time != b.Reminder.Time {
+			return a.Reminder.Time < b.Reminder.Time
+		}
+		return a.Reminder.ID < b.Reminder.ID
+	})
+
+	return occurrences, nil
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"urgent-reminder/internal/models"
+)
+
+func formatDates(dates []time.Time) []string {
+	formatted := make([]string, len(dates))
+	for i, d := range dates {
+		formatted[i] = d.Format("2006-01-02")
+	}
+	return formatted
+}
+
+func TestOccurrencesBetween(t *testing.T) {
+	weekly := models.NewRecurrentReminder(1, "Gym", utcDate(2026, 1, 5), models.RecurrentWeekly)
+	weekly.RecurrentDays = []string{"Mon", "Thu"}
+	weekly.Tags = []string{"health"}
+
+	monthly := models.NewRecurrentReminder(2, "Rent", utcDate(2026, 1, 31), models.RecurrentMonthly)
+	monthly.RecurrentDayOfMonth = 31
+	monthly.Project = "home"
+
+	oneOff := models.NewReminder(3, "Dentist", utcDate(2026, 1, 8))
+	oneOff.Time = "09:00"
+	oneOff.Tags = []string{"health"}
+
+	early := models.NewReminder(4, "Breakfast", utcDate(2026, 1, 8))
+	early.Time = "07:30"
+
+	s := newTestService(t, weekly, monthly, oneOff, early)
+
+	tests := []struct {
+		name     string
+		from, to time.Time
+		query    ReminderQuery
+		want     []string
+	}{
+		{
+			name: "all-day first, then by time",
+			from: date(2026, 1, 1), to: date(2026, 1, 15),
+			want: []string{"2026-01-05 #1", "2026-01-08 #1", "2026-01-08 #4", "2026-01-08 #3", "2026-01-12 #1", "2026-01-15 #1"},
+		},
+		{
+			name: "month end clamps",
+			from: date(2026, 1, 20), to: date(2026, 4, 30),
+			query: ReminderQuery{Project: "home"},
+			want:  []string{"2026-01-31 #2", "2026-02-28 #2", "2026-03-31 #2", "2026-04-30 #2"},
+		},
+		{
+			name: "no matching tag",
+			from: date(2026, 1, 1), to: date(2026, 3, 31),
+			query: ReminderQuery{Tags: []string{"missing"}},
+			want:  []string{},
+		},
+		{
+			name: "tag filter",
+			from: date(2026, 1, 6), to: date(2026, 1, 9),
+			query: ReminderQuery{Tags: []string{"health"}},
+			want:  []string{"2026-01-08 #1", "2026-01-08 #3"},
+		},
+		{
+			name: "range before first due",
+			from: date(2025, 12, 1), to: date(2025, 12, 31),
+			want: []string{},
+		},
+		{
+			name: "ignores time of day in bounds",
+			from: date(2026, 1, 12).Add(18 * time.Hour), to: date(2026, 1, 12).Add(time.Hour),
+			want: []string{"2026-01-12 #1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			occurrences, err := s.OccurrencesBetween(tt.from, tt.to, tt.query)
+			if err != nil {
+				t.Fatalf("OccurrencesBetween: %v", err)
+			}
+
+			got := []string{}
+			for _, o := range occurrences {
+				got = append(got, fmt.Sprintf("%s #%d", o.Date.Format("2006-01-02"), o.Reminder.ID))
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("OccurrencesBetween() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpcomingOccurrences(t *testing.T) {
+	weekly := models.NewRecurrentReminder(1, "Gym", utcDate(2026, 1, 5), models.RecurrentWeekly)
+	weekly.RecurrentDays = []string{"Mon"}
+
+	monthly := models.NewRecurrentReminder(2, "Rent", utcDate(2026, 1, 31), models.RecurrentMonthly)
+	monthly.RecurrentDayOfMonth = 31
+
+	biWeekly := models.NewRecurrentReminder(3, "Payroll", utcDate(2026, 1, 9), models.RecurrentBiWeekly)
+	biWeekly.RecurrentDays = []string{"Fri"}
+
+	oneOff := models.NewReminder(4, "Dentist", utcDate(2026, 1, 8))
+
+	s := &ReminderService{}
+
+	tests := []struct {
+		name     string
+		reminder *models.Reminder
+		now      time.Time
+		count    int
+		want     []string
+	}{
+		{name: "includes pending due date", reminder: weekly, now: date(2026, 1, 1), count: 3, want: []string{"2026-01-05", "2026-01-12", "2026-01-19"}},
+		{name: "overdue starts after now", reminder: weekly, now: date(2026, 1, 7).Add(10 * time.Hour), count: 2, want: []string{"2026-01-12", "2026-01-19"}},
+		{name: "monthly clamps to month end", reminder: monthly, now: date(2026, 1, 1), count: 4, want: []string{"2026-01-31", "2026-02-28", "2026-03-31", "2026-04-30"}},
+		{name: "bi-weekly", reminder: biWeekly, now: date(2026, 1, 1), count: 3, want: []string{"2026-01-09", "2026-01-23", "2026-02-06"}},
+		{name: "single pending", reminder: weekly, now: date(2026, 1, 1), count: 1, want: []string{"2026-01-05"}},
+		{name: "zero count", reminder: weekly, now: date(2026, 1, 1), count: 0, want: []string{}},
+		{name: "one-off", reminder: oneOff, now: date(2026, 1, 1), count: 3, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := formatDates(s.UpcomingOccurrences(tt.reminder, tt.now, tt.count))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("UpcomingOccurrences() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/models"
+)
+
+func (s *ReminderService) AddBlocker(id, blockerID int) error {
+	if id == blockerID {
+		return i18n.Errorf("a reminder cannot block itself")
+	}
+
+	reminders, err := s.store.LoadReminders()
+	if err != nil {
+		return err
+	}
+
+	byID := indexReminders(reminders)
+	reminder, ok := byID[id]
+	if !ok {
+		return i18n.Errorf("reminder with ID %d not found", id)
+	}
+	if _, ok := byID[blockerID]; !ok {
+		return i18n.Errorf("reminder with ID %d not found", blockerID)
+	}
+
+	for _, existing := range reminder.BlockedBy {
+		if existing == blockerID {
+			return nil
+		}
+	}
+
+	if path := dependencyPath(byID, blockerID, id); path != nil {
+		return i18n.Errorf("reminder %d cannot be blocked by %d: that would create a cycle %s", id, blockerID, formatCycle(append([]int{id}, path...)))
+	}
+
+	reminder.BlockedBy = append(reminder.BlockedBy, blockerID)
+	sort.Ints(reminder.BlockedBy)
+	return s.store.UpdateReminder(id, reminder)
+}
+
+func (s *ReminderService) RemoveBlocker(id, blockerID int) error {
+	reminder, err := s.GetReminder(id)
+	if err != nil {
+		return err
+	}
+
+	reminder.BlockedBy = removeID(reminder.BlockedBy, blockerID)
+	return s.store.UpdateReminder(id, reminder)
+}
+
+func (s *ReminderService) ClearBlockers(id int) error {
+	reminder, err := s.GetReminder(id)
+	if err != nil {
+		return err
+	}
+
+	reminder.BlockedBy = nil
+	return s.store.UpdateReminder(id, reminder)
+}
+
+func (s *ReminderService) Dependents(id int) ([]*models.Reminder, error) {
+	reminders, err := s.store.LoadReminders()
+	if err != nil {
+		return nil, err
+	}
+
+	var dependents []*models.Reminder
+	for _, r := range reminders {
+		for _, blockerID := range r.BlockedBy {
+			if blockerID == id {
+				dependents = append(dependents, r)
+				break
+			}
+		}
+	}
+	return dependents, nil
+}
+
+func (s *ReminderService) IsBlocked(reminder *models.Reminder) (bool, error) {
+	reminders, err := s.store.LoadReminders()
+	if err != nil {
+		return false, err
+	}
+	return isBlocked(reminder, indexReminders(reminders)), nil
+}
+
+func (s *ReminderService) BlockedIDs() (map[int]bool, error) {
+	reminders, err := s.store.LoadReminders()
+	if err != nil {
+		return nil, err
+	}
+
+	byID := indexReminders(reminders)
+	blocked := map[int]bool{}
+	for _, r := range reminders {
+		if isBlocked(r, byID) {
+			blocked[r.ID] = true
+		}
+	}
+	return blocked, nil
+}
+
+func (s *ReminderService) DeleteReminder(id int) error {
+	if err := s.store.DeleteReminder(id); err != nil {
+		return err
+	}
+	return s.releaseDependents(id)
+}
+
+func (s *ReminderService) releaseDependents(id int) error {
+	reminders, err := s.store.LoadReminders()
+	if err != nil {
+		return err
+	}
+
+	changed := false
+	for _, r := range reminders {
+		remaining := removeID(r.BlockedBy, id)
+		if len(remaining) != len(r.BlockedBy) {
+			r.BlockedBy = remaining
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+	return s.store.SaveReminders(reminders)
+}
+
+func isBlocked(reminder *models.Reminder, byID map[int]*models.Reminder) bool {
+	for _, blockerID := range reminder.BlockedBy {
+		if _, ok := byID[blockerID]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func indexReminders(reminders []*models.Reminder) map[int]*models.Reminder {
+	byID := make(map[int]*models.Reminder, len(reminders))
+	for _, r := range reminders {
+		byID[r.ID] = r
+	}
+	return byID
+}
+
+func dependencyPath(byID map[int]*models.Reminder, from, to int) []int {
+	visited := map[int]bool{}
+
+	var visit func(id int) []int
+	visit = func(id int) []int {
+		if id == to {
+			return []int{id}
+		}
+		if visited[id] {
+			return nil
+		}
+		visited[id] = true
+
+		reminder, ok := byID[id]
+		if !ok {
+			return nil
+		}
+		for _, next := range reminder.BlockedBy {
+			if path := visit(next); path != nil {
+				return append([]int{id}, path...)
+			}
+		}
+		return nil
+	}
+
+	return visit(from)
+}
+
+func formatCycle(path []int) string {
+	formatted := ""
+	for i, id := range path {
+		if i > 0 {
+			formatted += " -> "
+		}
+		formatted += fmt.Sprintf("%d", id)
+	}
+	return "(" + formatted + ")"
+}
+
+func removeID(ids []int, id int) []int {
+	var remaining []int
+	for _, existing := range ids {
+		if existing != id {
+			remaining = append(remaining, existing)
+		}
+	}
+	return remaining
+}
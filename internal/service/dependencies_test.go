@@ -0,0 +1,90 @@
+package service
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"urgent-reminder/internal/models"
+)
+
+func TestAddBlocker(t *testing.T) {
+	type edge struct{ id, blocker int }
+
+	tests := []struct {
+		name    string
+		edges   []edge
+		add     edge
+		want    []int
+		wantErr string
+	}{
+		{name: "simple", add: edge{2, 1}, want: []int{1}},
+		{name: "already blocked", edges: []edge{{2, 1}}, add: edge{2, 1}, want: []int{1}},
+		{name: "sorted", edges: []edge{{4, 3}}, add: edge{4, 1}, want: []int{1, 3}},
+		{name: "self", add: edge{1, 1}, wantErr: "cannot block itself"},
+		{name: "missing blocker", add: edge{1, 9}, wantErr: "ID 9 not found"},
+		{name: "direct cycle", edges: []edge{{2, 1}}, add: edge{1, 2}, wantErr: "(1 -> 2 -> 1)"},
+		{name: "long cycle", edges: []edge{{2, 1}, {3, 2}, {4, 3}}, add: edge{1, 4}, wantErr: "(1 -> 4 -> 3 -> 2 -> 1)"},
+		{name: "diamond is not a cycle", edges: []edge{{2, 1}, {3, 1}, {4, 2}}, add: edge{4, 3}, want: []int{2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reminders []*models.Reminder
+			for id := 1; id <= 4; id++ {
+				reminders = append(reminders, models.NewReminder(id, "Task", dueIn(id)))
+			}
+			s := newTestService(t, reminders...)
+
+			for _, e := range tt.edges {
+				if err := s.AddBlocker(e.id, e.blocker); err != nil {
+					t.Fatalf("AddBlocker(%d, %d): %v", e.id, e.blocker, err)
+				}
+			}
+
+			err := s.AddBlocker(tt.add.id, tt.add.blocker)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("AddBlocker(%d, %d) error = %v, want %q", tt.add.id, tt.add.blocker, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AddBlocker(%d, %d): %v", tt.add.id, tt.add.blocker, err)
+			}
+
+			reminder, err := s.GetReminder(tt.add.id)
+			if err != nil {
+				t.Fatalf("GetReminder: %v", err)
+			}
+			if !slices.Equal(reminder.BlockedBy, tt.want) {
+				t.Errorf("BlockedBy = %v, want %v", reminder.BlockedBy, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeleteReleasesDependents(t *testing.T) {
+	s := newTestService(t,
+		models.NewReminder(1, "Blocker", dueIn(0)),
+		models.NewReminder(2, "Other blocker", dueIn(0)),
+		models.NewReminder(3, "Dependent", dueIn(0)),
+	)
+	for _, blocker := range []int{1, 2} {
+		if err := s.AddBlocker(3, blocker); err != nil {
+			t.Fatalf("AddBlocker(3, %d): %v", blocker, err)
+		}
+	}
+
+	if err := s.DeleteReminder(1); err != nil {
+		t.Fatalf("DeleteReminder: %v", err)
+	}
+
+	dependent, err := s.GetReminder(3)
+	if err != nil {
+		t.Fatalf("GetReminder: %v", err)
+	}
+	if !slices.Equal(dependent.BlockedBy, []int{2}) {
+		t.Errorf("BlockedBy = %v, want [2]", dependent.BlockedBy)
+	}
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/models"
+)
+
+type SortField string
+
+const (
+	SortByDue     SortField = "due"
+	SortByID      SortField = "id"
+	SortByTitle   SortField = "title"
+	SortByCreated SortField = "created"
+	SortByUrgency SortField = "urgency"
+)
+
+var SortFields = []SortField{SortByUrgency, SortByDue, SortByID, SortByTitle, SortByCreated}
+
+func ParseSortField(input string) (SortField, error) {
+	for _, field := range SortFields {
+		if string(field) == input {
+			return field, nil
+		}
+	}
+
+	names := make([]string, len(SortFields))
+	for i, field := range SortFields {
+		names[i] = string(field)
+	}
+	return "", i18n.Errorf("invalid sort field %q, use one of: %s", input, strings.Join(names, ", "))
+}
+
+type BlockedFilter int
+
+const (
+	ExcludeBlocked BlockedFilter = iota
+	OnlyBlocked
+	AnyBlocked
+)
+
+func (q ReminderQuery) IsDefault() bool {
+	return !q.All && !q.Overdue && q.Upcoming == 0 && q.From.IsZero() && q.To.IsZero()
+}
+
+func (q ReminderQuery) IsFiltered() bool {
+	return !q.IsDefault() || q.OnlyRecurrent || q.OnlyOneOff || q.IncludeWaiting ||
+		len(q.Tags) > 0 || q.Project != "" || len(q.Fields) > 0
+}
+
+type ReminderQuery struct {
+	All            bool
+	Overdue        bool
+	Upcoming       time.Duration
+	From           time.Time
+	To             time.Time
+	OnlyRecurrent  bool
+	OnlyOneOff     bool
+	Warning        bool
+	Tags           []string
+	Project        string
+	Fields         map[string]string
+	Blocked        BlockedFilter
+	IncludeWaiting bool
+	SortBy         SortField
+}
+
+func (q ReminderQuery) MatchesLabels(r *models.Reminder) bool {
+	for _, tag := range q.Tags {
+		if !r.HasTag(tag) {
+			return false
+		}
+	}
+	if q.Project != "" && !strings.EqualFold(r.Project, q.Project) {
+		return false
+	}
+	for name, value := range q.Fields {
+		if !strings.EqualFold(r.Fields[name], value) {
+			return false
+		}
+	}
+	return true
+}
+
+func (q ReminderQuery) Matches(r *models.Reminder, now time.Time) bool {
+	if !q.MatchesLabels(r) {
+		return false
+	}
+	if q.OnlyRecurrent && !r.IsRecurrent {
+		return false
+	}
+	if q.OnlyOneOff && r.IsRecurrent {
+		return false
+	}
+
+	if q.Warning {
+		return !r.IsDue() && (r.IsInWarningWindow(now) || r.HasStarted(now))
+	}
+
+	if q.All {
+		return true
+	}
+
+	due := r.DueDateTime()
+
+	if q.Overdue && !r.IsOverdue() {
+		return false
+	}
+	if q.Upcoming > 0 && due.After(now.Add(q.Upcoming)) {
+		return false
+	}
+	if !q.From.IsZero() && due.Before(q.From) {
+		return false
+	}
+	if !q.To.IsZero() && !due.Before(q.To.AddDate(0, 0, 1)) {
+		return false
+	}
+
+	if q.IsDefault() {
+		return r.IsDue()
+	}
+
+	return true
+}
+
+func (s *ReminderService) QueryReminders(q ReminderQuery) ([]*models.Reminder, error) {
+	reminders, err := s.store.LoadReminders()
+	if err != nil {
+		return nil, err
+	}
+
+	matched := filterReminders(reminders, q, time.Now())
+	SortReminders(matched, q.SortBy)
+	return matched, nil
+}
+
+func filterReminders(reminders []*models.Reminder, q ReminderQuery, now time.Time) []*models.Reminder {
+	byID := indexReminders(reminders)
+
+	var matched []*models.Reminder
+	for _, r := range reminders {
+		if !q.Matches(r, now) {
+			continue
+		}
+		if !q.IncludeWaiting && r.IsWaiting(now) {
+			continue
+		}
+
+		blocked := isBlocked(r, byID)
+		if q.Blocked == ExcludeBlocked && blocked || q.Blocked == OnlyBlocked && !blocked {
+			continue
+		}
+
+		matched = append(matched, r)
+	}
+	return matched
+}
+
+func SortReminders(reminders []*models.Reminder, field SortField) {
+	var less func(a, b *models.Reminder) bool
+
+	switch field {
+	case SortByUrgency:
+		now := time.Now()
+		less = func(a, b *models.Reminder) bool {
+			return a.Urgency(now) > b.Urgency(now)
+		}
+	case SortByDue:
+		less = func(a, b *models.Reminder) bool {
+			return a.DueDateTime().Before(b.DueDateTime())
+		}
+	case SortByTitle:
+		less = func(a, b *models.Reminder) bool {
+			return strings.ToLower(a.Title) < strings.ToLower(b.Title)
+		}
+	case SortByCreated:
+		less = func(a, b *models.Reminder) bool {
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+	default:
+		less = func(a, b *models.Reminder) bool {
+			return a.ID < b.ID
+		}
+	}
+
+	sort.SliceStable(reminders, func(i, j int) bool {
+		if less(reminders[i], reminders[j]) {
+			return true
+		}
+		if less(reminders[j], reminders[i]) {
+			return false
+		}
+		return reminders[i].ID < reminders[j].ID
+	})
+}
+
+type TagCount struct {
+	Tag   string
+	Count int
+}
+
+func (s *ReminderService) TagCounts(q ReminderQuery) ([]TagCount, error) {
+	reminders, err := s.QueryReminders(q)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int{}
+	for _, r := range reminders {
+		for _, tag := range r.Tags {
+			counts[tag]++
+		}
+	}
+
+	tagCounts := make([]TagCount, 0, len(counts))
+	for tag, count := range counts {
+		tagCounts = append(tagCounts, TagCount{Tag: tag, Count: count})
+	}
+
+	sort.Slice(tagCounts, func(i, j int) bool {
+		if tagCounts[i].Count != tagCounts[j].Count {
+			return tagCounts[i].Count > tagCounts[j].Count
+		}
+		return tagCounts[i].Tag < tagCounts[j].Tag
+	})
+
+	return tagCounts, nil
+}
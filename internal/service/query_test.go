@@ -0,0 +1,150 @@
+package service
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"urgent-reminder/internal/models"
+	"urgent-reminder/internal/timeutil"
+)
+
+func queryFixture() []*models.Reminder {
+	overdue := models.NewReminder(1, "Overdue report", dueIn(-3))
+	overdue.Tags = []string{"work"}
+	overdue.Project = "ops"
+
+	standup := models.NewRecurrentReminder(2, "Today standup", dueIn(0), models.RecurrentWeekly)
+	standup.Tags = []string{"work"}
+
+	dentist := models.NewReminder(3, "Dentist", dueIn(2))
+	dentist.Fields = map[string]string{"ticket": "OPS-12"}
+
+	taxes := models.NewReminder(4, "Taxes", dueIn(20))
+	taxes.Project = "home"
+
+	blocked := models.NewReminder(5, "Blocked thing", dueIn(-1))
+	blocked.BlockedBy = []int{4}
+
+	waitUntil := dueIn(5)
+	waiting := models.NewReminder(6, "Waiting thing", dueIn(-1))
+	waiting.WaitUntil = &waitUntil
+
+	return []*models.Reminder{overdue, standup, dentist, taxes, blocked, waiting}
+}
+
+func TestQueryReminders(t *testing.T) {
+	tests := []struct {
+		name  string
+		query ReminderQuery
+		want  []int
+	}{
+		{name: "default shows due", query: ReminderQuery{}, want: []int{1, 2}},
+		{name: "all", query: ReminderQuery{All: true}, want: []int{1, 2, 3, 4}},
+		{name: "all with waiting", query: ReminderQuery{All: true, IncludeWaiting: true}, want: []int{1, 2, 3, 4, 6}},
+		{name: "only blocked", query: ReminderQuery{All: true, Blocked: OnlyBlocked}, want: []int{5}},
+		{name: "any blocked", query: ReminderQuery{All: true, Blocked: AnyBlocked}, want: []int{1, 2, 3, 4, 5}},
+		{name: "overdue skips today", query: ReminderQuery{Overdue: true}, want: []int{1}},
+		{name: "upcoming window", query: ReminderQuery{Upcoming: 7 * timeutil.Day}, want: []int{1, 2, 3}},
+		{name: "date range", query: ReminderQuery{From: today().AddDate(0, 0, 1), To: today().AddDate(0, 0, 20)}, want: []int{3, 4}},
+		{name: "single day range", query: ReminderQuery{From: today().AddDate(0, 0, 20), To: today().AddDate(0, 0, 20)}, want: []int{4}},
+		{name: "to is inclusive", query: ReminderQuery{To: today()}, want: []int{1, 2}},
+		{name: "recurrent", query: ReminderQuery{All: true, OnlyRecurrent: true}, want: []int{2}},
+		{name: "one-off", query: ReminderQuery{All: true, OnlyOneOff: true}, want: []int{1, 3, 4}},
+		{name: "tag keeps due filter", query: ReminderQuery{Tags: []string{"work"}}, want: []int{1, 2}},
+		{name: "project ignores case", query: ReminderQuery{All: true, Project: "OPS"}, want: []int{1}},
+		{name: "field ignores case", query: ReminderQuery{All: true, Fields: map[string]string{"ticket": "ops-12"}}, want: []int{3}},
+		{name: "no match", query: ReminderQuery{All: true, Tags: []string{"missing"}}, want: []int{}},
+	}
+
+	s := newTestService(t, queryFixture()...)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.query.SortBy = SortByID
+			reminders, err := s.QueryReminders(tt.query)
+			if err != nil {
+				t.Fatalf("QueryReminders: %v", err)
+			}
+			if got := reminderIDs(reminders); !slices.Equal(got, tt.want) {
+				t.Errorf("QueryReminders() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryIsFiltered(t *testing.T) {
+	tests := []struct {
+		name  string
+		query ReminderQuery
+		want  bool
+	}{
+		{name: "default", query: ReminderQuery{}, want: false},
+		{name: "sort only", query: ReminderQuery{SortBy: SortByDue}, want: false},
+		{name: "all", query: ReminderQuery{All: true}, want: true},
+		{name: "tag", query: ReminderQuery{Tags: []string{"work"}}, want: true},
+		{name: "project", query: ReminderQuery{Project: "ops"}, want: true},
+		{name: "recurrent", query: ReminderQuery{OnlyRecurrent: true}, want: true},
+		{name: "waiting", query: ReminderQuery{IncludeWaiting: true}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.query.IsFiltered(); got != tt.want {
+				t.Errorf("IsFiltered() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortReminders(t *testing.T) {
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	reminders := func() []*models.Reminder {
+		a := models.NewReminder(1, "charlie", dueIn(5))
+		a.CreatedAt = created.Add(2 * time.Hour)
+		b := models.NewReminder(2, "Alpha", dueIn(-2))
+		b.CreatedAt = created.Add(3 * time.Hour)
+		c := models.NewReminder(3, "bravo", dueIn(5))
+		c.CreatedAt = created
+		c.Priority = models.PriorityCritical
+		d := models.NewReminder(4, "delta", dueIn(1))
+		d.CreatedAt = created.Add(time.Hour)
+		return []*models.Reminder{d, c, b, a}
+	}
+
+	tests := []struct {
+		field SortField
+		want  []int
+	}{
+		{field: SortByID, want: []int{1, 2, 3, 4}},
+		{field: SortByDue, want: []int{2, 4, 1, 3}},
+		{field: SortByTitle, want: []int{2, 3, 1, 4}},
+		{field: SortByCreated, want: []int{3, 4, 1, 2}},
+		{field: SortByUrgency, want: []int{3, 2, 4, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.field), func(t *testing.T) {
+			sorted := reminders()
+			SortReminders(sorted, tt.field)
+			if got := reminderIDs(sorted); !slices.Equal(got, tt.want) {
+				t.Errorf("SortReminders(%s) = %v, want %v", tt.field, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSortField(t *testing.T) {
+	for _, field := range SortFields {
+		got, err := ParseSortField(string(field))
+		if err != nil || got != field {
+			t.Errorf("ParseSortField(%q) = %q, %v", field, got, err)
+		}
+	}
+
+	for _, input := range []string{"", "Due", "priority"} {
+		if _, err := ParseSortField(input); err == nil {
+			t.Errorf("ParseSortField(%q) succeeded, want error", input)
+		}
+	}
+}
@@ -1,11 +1,12 @@
 package service
 
 import (
-	"fmt"
 	"time"
 
+	"urgent-reminder/internal/i18n"
 	"urgent-reminder/internal/models"
 	"urgent-reminder/internal/storage"
+	"urgent-reminder/internal/timeutil"
 )
 
 type ReminderService struct {
@@ -20,6 +21,10 @@ func (s *ReminderService) AddReminder(reminder *models.Reminder) error {
 	return s.store.AddReminder(reminder)
 }
 
+func (s *ReminderService) UpdateReminder(reminder *models.Reminder) error {
+	return s.store.UpdateReminder(reminder.ID, reminder)
+}
+
 func (s *ReminderService) ListReminders() ([]*models.Reminder, error) {
 	return s.store.LoadReminders()
 }
@@ -52,7 +57,7 @@ func (s *ReminderService) GetReminder(id int) (*models.Reminder, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("reminder with ID %d not found", id)
+	return nil, i18n.Errorf("reminder with ID %d not found", id)
 }
 
 func (s *ReminderService) CheckReminder(id int) error {
@@ -64,28 +69,165 @@ func (s *ReminderService) CheckReminder(id int) error {
 	if reminder.IsRecurrent {
 		nextDueDate, err := s.calculateNextDueDate(reminder)
 		if err != nil {
-			return fmt.Errorf("failed to calculate next due date: %w", err)
+			return i18n.Errorf("failed to calculate next due date: %w", err)
 		}
+		shiftStartDates(reminder, daysBetween(reminder.DueDate, nextDueDate))
 		reminder.DueDate = nextDueDate
-		return s.store.UpdateReminder(id, reminder)
+		reminder.ResetSubtasks()
+		if err := s.store.UpdateReminder(id, reminder); err != nil {
+			return err
+		}
+		return s.releaseDependents(id)
 	}
 
-	return s.store.DeleteReminder(id)
+	return s.DeleteReminder(id)
 }
 
-func (s *ReminderService) calculateNextDueDate(reminder *models.Reminder) (time.Time, error) {
+func shiftStartDates(reminder *models.Reminder, days int) {
+	if days <= 0 {
+		return
+	}
+	if reminder.ScheduledDate != nil {
+		scheduled := reminder.ScheduledDate.AddDate(0, 0, days)
+		reminder.ScheduledDate = &scheduled
+	}
+	if reminder.WaitUntil != nil {
+		waitUntil := reminder.WaitUntil.AddDate(0, 0, days)
+		reminder.WaitUntil = &waitUntil
+	}
+}
+
+func daysBetween(from, to time.Time) int {
+	start := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	end := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(end.Sub(start).Hours() / 24)
+}
+
+func (s *ReminderService) AddSubtask(id int, title string) (*models.Reminder, error) {
+	reminder, err := s.GetReminder(id)
+	if err != nil {
+		return nil, err
+	}
+
+	reminder.Subtasks = append(reminder.Subtasks, models.Subtask{Title: title})
+	if err := s.store.UpdateReminder(id, reminder); err != nil {
+		return nil, err
+	}
+	return reminder, nil
+}
+
+func (s *ReminderService) SetSubtaskDone(id int, number int, done bool) (*models.Reminder, error) {
+	reminder, err := s.GetReminder(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if number < 1 || number > len(reminder.Subtasks) {
+		return nil, i18n.Errorf("reminder with ID %d has no subtask %d", id, number)
+	}
+
+	reminder.Subtasks[number-1].Done = done
+	if err := s.store.UpdateReminder(id, reminder); err != nil {
+		return nil, err
+	}
+	return reminder, nil
+}
+
+func (s *ReminderService) SnoozeReminder(id int, duration time.Duration) (*models.Reminder, error) {
+	if duration <= 0 {
+		return nil, i18n.Errorf("snooze duration must be positive")
+	}
+
+	reminder, err := s.GetReminder(id)
+	if err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
+	due := reminder.DueDateTime()
+
+	var next time.Time
+	if duration%timeutil.Day == 0 {
+		day := time.Date(due.Year(), due.Month(), due.Day(), 0, 0, 0, 0, time.Local)
+		if today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local); day.Before(today) {
+			day = today
+		}
+		next = day.AddDate(0, 0, int(duration/timeutil.Day))
+	} else {
+		next = now.Add(duration)
+		reminder.Time = next.Format("15:04")
+	}
+
+	reminder.DueDate = time.Date(next.Year(), next.Month(), next.Day(), 0, 0, 0, 0, time.UTC)
+	if err := s.store.UpdateReminder(id, reminder); err != nil {
+		return nil, err
+	}
+	return reminder, nil
+}
+
+func (s *ReminderService) calculateNextDueDate(reminder *models.Reminder) (time.Time, error) {
+	return s.nextOccurrenceAfter(reminder, time.Now()), nil
+}
+
+func (s *ReminderService) NextOccurrences(reminder *models.Reminder, after time.Time, count int) []time.Time {
+	if !reminder.IsRecurrent {
+		return nil
+	}
+
+	var occurrences []time.Time
+	for len(occurrences) < count {
+		next := s.nextOccurrenceAfter(reminder, after)
+		occurrences = append(occurrences, next)
+		after = next
+	}
+
+	return occurrences
+}
+
+func (s *ReminderService) UpcomingOccurrences(reminder *models.Reminder, now time.Time, count int) []time.Time {
+	if !reminder.IsRecurrent || count <= 0 {
+		return nil
+	}
+
+	due := reminder.DueDateTime()
+	if !due.After(now) {
+		return s.NextOccurrences(reminder, now, count)
+	}
+
+	pending := time.Date(due.Year(), due.Month(), due.Day(), 0, 0, 0, 0, time.Local)
+	return append([]time.Time{pending}, s.NextOccurrences(reminder, due, count-1)...)
+}
+
+func (s *ReminderService) FirstOccurrence(reminder *models.Reminder, from time.Time) time.Time {
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.Local)
+
+	switch reminder.RecurrentType {
+	case models.RecurrentWeekly, models.RecurrentBiWeekly:
+		if len(reminder.RecurrentDays) == 0 {
+			return day
+		}
+		next := s.nextWeeklyOccurrence(reminder, day.Add(-time.Nanosecond))
+		return time.Date(next.Year(), next.Month(), next.Day(), 0, 0, 0, 0, time.Local)
+	default:
+		return s.nextOccurrenceAfter(reminder, day.Add(-time.Nanosecond))
+	}
+}
+
+func (s *ReminderService) nextOccurrenceAfter(reminder *models.Reminder, now time.Time) time.Time {
+	var next time.Time
 
 	switch reminder.RecurrentType {
 	case models.RecurrentWeekly:
-		return s.nextWeeklyOccurrence(reminder, now), nil
+		next = s.nextWeeklyOccurrence(reminder, now)
 	case models.RecurrentBiWeekly:
-		return s.nextBiWeeklyOccurrence(reminder, now), nil
+		next = s.nextBiWeeklyOccurrence(reminder, now)
 	case models.RecurrentMonthly:
-		return s.nextMonthlyOccurrence(reminder, now), nil
+		next = s.nextMonthlyOccurrence(reminder, now)
 	default:
-		return now.AddDate(1, 0, 0), nil
+		next = now.AddDate(1, 0, 0)
 	}
+
+	return time.Date(next.Year(), next.Month(), next.Day(), 0, 0, 0, 0, time.Local)
 }
 
 func (s *ReminderService) nextWeeklyOccurrence(reminder *models.Reminder, now time.Time) time.Time {
@@ -93,23 +235,7 @@ func (s *ReminderService) nextWeeklyOccurrence(reminder *models.Reminder, now ti
 		return now.AddDate(0, 0, 7)
 	}
 
-	dayMap := map[string]time.Weekday{
-		"Mon": time.Monday,
-		"Tue": time.Tuesday,
-		"Wed": time.Wednesday,
-		"Thu": time.Thursday,
-		"Fri": time.Friday,
-		"Sat": time.Saturday,
-		"Sun": time.Sunday,
-	}
-
-	var weekdays []time.Weekday
-	for _, day := range reminder.RecurrentDays {
-		if wd, ok := dayMap[day]; ok {
-			weekdays = append(weekdays, wd)
-		}
-	}
-
+	weekdays := reminder.Weekdays()
 	currentWeekday := now.Weekday()
 	minDays := 7
 
@@ -142,11 +268,12 @@ func (s *ReminderService) nextMonthlyOccurrence(reminder *models.Reminder, now t
 
 	for {
 		daysInMonth := time.Date(nextYear, nextMonth+1, 0, 0, 0, 0, 0, time.Local).Day()
-		if day > daysInMonth {
-			day = daysInMonth
+		monthDay := day
+		if monthDay > daysInMonth {
+			monthDay = daysInMonth
 		}
 
-		nextDate := time.Date(nextYear, nextMonth, day, 0, 0, 0, 0, time.Local)
+		nextDate := time.Date(nextYear, nextMonth, monthDay, 0, 0, 0, 0, time.Local)
 
 		if nextDate.After(now) {
 			return nextDate
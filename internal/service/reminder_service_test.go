@@ -0,0 +1,152 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"urgent-reminder/internal/models"
+	"urgent-reminder/internal/timeutil"
+)
+
+func TestDaysBetween(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to time.Time
+		want     int
+	}{
+		{name: "same day", from: date(2026, 1, 5), to: date(2026, 1, 5).Add(23 * time.Hour), want: 0},
+		{name: "one week", from: date(2026, 1, 5), to: date(2026, 1, 12), want: 7},
+		{name: "across february", from: date(2026, 1, 31), to: date(2026, 2, 28), want: 28},
+		{name: "across a year", from: date(2026, 12, 28), to: date(2027, 1, 4), want: 7},
+		{name: "backwards", from: date(2026, 1, 12), to: date(2026, 1, 5), want: -7},
+		{name: "mixed zones", from: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), to: date(2026, 3, 29), want: 28},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := daysBetween(tt.from, tt.to); got != tt.want {
+				t.Errorf("daysBetween(%v, %v) = %d, want %d", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShiftStartDates(t *testing.T) {
+	scheduled := time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)
+	waitUntil := time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		scheduled     *time.Time
+		waitUntil     *time.Time
+		days          int
+		wantScheduled string
+		wantWaitUntil string
+	}{
+		{name: "both dates", scheduled: &scheduled, waitUntil: &waitUntil, days: 7, wantScheduled: "2026-01-10", wantWaitUntil: "2026-01-11"},
+		{name: "scheduled only", scheduled: &scheduled, days: 31, wantScheduled: "2026-02-03"},
+		{name: "no dates", days: 7},
+		{name: "no shift", scheduled: &scheduled, waitUntil: &waitUntil, days: 0, wantScheduled: "2026-01-03", wantWaitUntil: "2026-01-04"},
+		{name: "negative shift", scheduled: &scheduled, days: -7, wantScheduled: "2026-01-03"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reminder := &models.Reminder{ScheduledDate: tt.scheduled, WaitUntil: tt.waitUntil}
+			shiftStartDates(reminder, tt.days)
+
+			if got := reminder.FormatScheduledDate(); got != tt.wantScheduled {
+				t.Errorf("scheduled date = %q, want %q", got, tt.wantScheduled)
+			}
+			if got := reminder.FormatWaitUntil(); got != tt.wantWaitUntil {
+				t.Errorf("wait-until date = %q, want %q", got, tt.wantWaitUntil)
+			}
+		})
+	}
+
+	if scheduled.Format("2006-01-02") != "2026-01-03" {
+		t.Errorf("shiftStartDates modified the original date: %v", scheduled)
+	}
+}
+
+func TestCheckReminderShiftsStartDates(t *testing.T) {
+	scheduled := dueIn(-3)
+	waitUntil := dueIn(-2)
+	reminder := models.NewRecurrentReminder(1, "Weekly review", dueIn(-1), models.RecurrentWeekly)
+	reminder.ScheduledDate = &scheduled
+	reminder.WaitUntil = &waitUntil
+
+	s := newTestService(t, reminder)
+	if err := s.CheckReminder(1); err != nil {
+		t.Fatalf("CheckReminder: %v", err)
+	}
+
+	checked, err := s.GetReminder(1)
+	if err != nil {
+		t.Fatalf("GetReminder: %v", err)
+	}
+
+	shift := daysBetween(dueIn(-1), checked.DueDate)
+	if shift <= 0 {
+		t.Fatalf("due date moved by %d days, want a later date", shift)
+	}
+	if got := daysBetween(scheduled, *checked.ScheduledDate); got != shift {
+		t.Errorf("scheduled date moved by %d days, want %d", got, shift)
+	}
+	if got := daysBetween(waitUntil, *checked.WaitUntil); got != shift {
+		t.Errorf("wait-until date moved by %d days, want %d", got, shift)
+	}
+}
+
+func TestSnoozeReminder(t *testing.T) {
+	tests := []struct {
+		name     string
+		due      time.Time
+		at       string
+		duration time.Duration
+		wantDays int
+		wantTime bool
+	}{
+		{name: "days from overdue", due: dueIn(-5), duration: 2 * timeutil.Day, wantDays: 2},
+		{name: "days from future", due: dueIn(3), duration: timeutil.Week, wantDays: 10},
+		{name: "days keep time", due: dueIn(1), at: "09:00", duration: timeutil.Day, wantDays: 2},
+		{name: "hours from now", due: dueIn(-5), duration: 2 * time.Hour, wantTime: true},
+		{name: "minutes from now", due: dueIn(3), at: "09:00", duration: 30 * time.Minute, wantTime: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reminder := models.NewReminder(1, "Snooze me", tt.due)
+			reminder.Time = tt.at
+			s := newTestService(t, reminder)
+
+			before := time.Now()
+			snoozed, err := s.SnoozeReminder(1, tt.duration)
+			if err != nil {
+				t.Fatalf("SnoozeReminder: %v", err)
+			}
+
+			if tt.wantTime {
+				due := snoozed.DueDateTime()
+				earliest := before.Add(tt.duration).Truncate(time.Minute)
+				latest := time.Now().Add(tt.duration)
+				if due.Before(earliest) || due.After(latest) {
+					t.Errorf("snoozed until %v, want between %v and %v", due, earliest, latest)
+				}
+				return
+			}
+
+			if got := daysBetween(today(), snoozed.DueDate); got != tt.wantDays {
+				t.Errorf("snoozed to %d days from today, want %d", got, tt.wantDays)
+			}
+			if snoozed.Time != tt.at {
+				t.Errorf("time = %q, want %q", snoozed.Time, tt.at)
+			}
+		})
+	}
+
+	s := newTestService(t, models.NewReminder(1, "Snooze me", dueIn(0)))
+	if _, err := s.SnoozeReminder(1, 0); err == nil {
+		t.Error("SnoozeReminder with a zero duration succeeded, want error")
+	}
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/models"
+)
+
+type SearchMode string
+
+const (
+	SearchSubstring SearchMode = "substring"
+	SearchRegex     SearchMode = "regex"
+	SearchFuzzy     SearchMode = "fuzzy"
+)
+
+type SearchResult struct {
+	Reminder *models.Reminder
+	Score    int
+}
+
+func (s *ReminderService) SearchReminders(query string, mode SearchMode, filter ReminderQuery) ([]SearchResult, error) {
+	reminders, err := s.store.LoadReminders()
+	if err != nil {
+		return nil, err
+	}
+
+	var match func(text string) (int, bool)
+
+	switch mode {
+	case SearchRegex:
+		re, err := regexp.Compile("(?i)" + query)
+		if err != nil {
+			return nil, i18n.Errorf("invalid regular expression: %w", err)
+		}
+		match = func(text string) (int, bool) {
+			loc := re.FindStringIndex(text)
+			if loc == nil {
+				return 0, false
+			}
+			return 100 - loc[0], true
+		}
+	case SearchFuzzy:
+		match = func(text string) (int, bool) {
+			return fuzzyScore(strings.ToLower(query), strings.ToLower(text))
+		}
+	default:
+		match = func(text string) (int, bool) {
+			return substringScore(strings.ToLower(query), strings.ToLower(text))
+		}
+	}
+
+	now := time.Now()
+
+	var results []SearchResult
+	for _, r := range reminders {
+		if !filter.Matches(r, now) {
+			continue
+		}
+
+		best, found := 0, false
+		for i, text := range searchableText(r) {
+			score, ok := match(text)
+			if !ok {
+				continue
+			}
+			// Title matches outrank matches in secondary fields.
+			if i > 0 {
+				score /= 2
+			}
+			if !found || score > best {
+				best, found = score, true
+			}
+		}
+		if found {
+			results = append(results, SearchResult{Reminder: r, Score: best})
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Reminder.ID < results[j].Reminder.ID
+	})
+
+	return results, nil
+}
+
+func (s *ReminderService) ResolveReminder(ref string) (*models.Reminder, error) {
+	reminder, _, err := s.ResolveReminderMatch(ref)
+	return reminder, err
+}
+
+func (s *ReminderService) ResolveReminderMatch(ref string) (*models.Reminder, bool, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return nil, false, i18n.Errorf("empty reminder reference")
+	}
+
+	reminders, err := s.store.LoadReminders()
+	if err != nil {
+		return nil, false, err
+	}
+
+	needle := strings.ToLower(ref)
+
+	if id, err := strconv.Atoi(ref); err == nil {
+		for _, r := range reminders {
+			if r.ID == id {
+				return r, true, nil
+			}
+		}
+		if !isUUIDPrefix(needle) {
+			return nil, false, i18n.Errorf("reminder with ID %d not found", id)
+		}
+	}
+
+	if isUUIDPrefix(needle) {
+		var matches []*models.Reminder
+		for _, r := range reminders {
+			if strings.HasPrefix(r.UUID, needle) {
+				matches = append(matches, r)
+			}
+		}
+		if len(matches) == 1 {
+			return matches[0], true, nil
+		}
+		if len(matches) > 1 {
+			return nil, false, i18n.Errorf("UUID prefix %q matches %d reminders, use more characters", ref, len(matches))
+		}
+	}
+
+	var exact, partial []*models.Reminder
+	for _, r := range reminders {
+		title := strings.ToLower(r.Title)
+		if title == needle {
+			exact = append(exact, r)
+		} else if strings.Contains(title, needle) {
+			partial = append(partial, r)
+		}
+	}
+
+	candidates := exact
+	if len(candidates) == 0 {
+		candidates = partial
+	}
+
+	switch len(candidates) {
+	case 0:
+		return nil, false, i18n.Errorf("no reminder matches %q", ref)
+	case 1:
+		return candidates[0], len(exact) == 1, nil
+	default:
+		var names []string
+		for _, r := range candidates {
+			names = append(names, fmt.Sprintf("[%d] %s", r.ID, r.Title))
+		}
+		return nil, false, i18n.Errorf("%q matches %d reminders: %s", ref, len(candidates), strings.Join(names, ", "))
+	}
+}
+
+func isUUIDPrefix(ref string) bool {
+	if len(ref) < 4 {
+		return false
+	}
+	for _, ch := range ref {
+		if !(ch >= '0' && ch <= '9' || ch >= 'a' && ch <= 'f' || ch == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+func searchableText(r *models.Reminder) []string {
+	return []string{r.Title, r.Notes}
+}
+
+func substringScore(query, text string) (int, bool) {
+	idx := strings.Index(text, query)
+	if idx < 0 {
+		return 0, false
+	}
+
+	score := 100
+	if idx == 0 {
+		score += 50
+	} else if isWordBoundary(text, idx) {
+		score += 25
+	}
+	if len(query) == len(text) {
+		score += 50
+	}
+	return score - idx, true
+}
+
+func fuzzyScore(query, text string) (int, bool) {
+	if query == "" {
+		return 0, true
+	}
+
+	if score, ok := substringScore(query, text); ok {
+		return score + 100, true
+	}
+
+	queryRunes := []rune(query)
+	textRunes := []rune(text)
+
+	score := 0
+	qi := 0
+	prevMatch := -2
+	for ti, ch := range textRunes {
+		if qi == len(queryRunes) {
+			break
+		}
+		if ch != queryRunes[qi] {
+			continue
+		}
+
+		score += 10
+		if ti == prevMatch+1 {
+			score += 15
+		}
+		if ti == 0 || !unicode.IsLetter(textRunes[ti-1]) && !unicode.IsDigit(textRunes[ti-1]) {
+			score += 10
+		}
+		prevMatch = ti
+		qi++
+	}
+
+	if qi < len(queryRunes) {
+		return 0, false
+	}
+
+	return score - len(textRunes)/4, true
+}
+
+func isWordBoundary(text string, idx int) bool {
+	prev := rune(text[idx-1])
+	return !unicode.IsLetter(prev) && !unicode.IsDigit(prev)
+}
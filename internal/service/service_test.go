@@ -0,0 +1,49 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"urgent-reminder/internal/models"
+	"urgent-reminder/internal/storage"
+)
+
+func newTestService(t *testing.T, reminders ...*models.Reminder) *ReminderService {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+
+	store, err := storage.NewJSONStore()
+	if err != nil {
+		t.Fatalf("NewJSONStore: %v", err)
+	}
+	if err := store.SaveReminders(reminders); err != nil {
+		t.Fatalf("SaveReminders: %v", err)
+	}
+	return NewReminderService(store)
+}
+
+func today() time.Time {
+	now := time.Now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+}
+
+func dueIn(days int) time.Time {
+	day := today().AddDate(0, 0, days)
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+}
+
+func utcDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func reminderIDs(reminders []*models.Reminder) []int {
+	ids := make([]int, len(reminders))
+	for i, r := range reminders {
+		ids[i] = r.ID
+	}
+	return ids
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"time"
+
+	"urgent-reminder/internal/timeutil"
+)
+
+type Summary struct {
+	Overdue  int `json:"overdue"`
+	Today    int `json:"today"`
+	Upcoming int `json:"upcoming"`
+}
+
+func (s Summary) Total() int {
+	return s.Overdue + s.Today
+}
+
+func (s *ReminderService) Summarize(now time.Time) (Summary, time.Time, error) {
+	reminders, err := s.store.LoadReminders()
+	if err != nil {
+		return Summary{}, time.Time{}, err
+	}
+
+	var summary Summary
+	for _, r := range filterReminders(reminders, ReminderQuery{}, now) {
+		if r.IsOverdue() {
+			summary.Overdue++
+		} else {
+			summary.Today++
+		}
+	}
+	summary.Upcoming = len(filterReminders(reminders, ReminderQuery{Warning: true}, now))
+
+	validUntil := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local).Add(timeutil.Day)
+	changesAt := func(t time.Time) {
+		if t.After(now) && t.Before(validUntil) {
+			validUntil = t
+		}
+	}
+	for _, r := range reminders {
+		due := r.DueDateTime()
+		if r.Time != "" {
+			changesAt(due)
+		}
+		for _, lead := range r.LeadTimes() {
+			changesAt(due.Add(-lead))
+		}
+	}
+
+	return summary, validUntil, nil
+}
@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"urgent-reminder/internal/i18n"
+)
+
+type Cache struct {
+	path     string
+	dataPath string
+	modTime  int64
+	size     int64
+}
+
+type cacheEntry struct {
+	DataModTime int64           `json:"data_mod_time"`
+	DataSize    int64           `json:"data_size"`
+	ValidUntil  time.Time       `json:"valid_until"`
+	Value       json.RawMessage `json:"value"`
+}
+
+func CacheDir() (string, error) {
+	cacheHome := os.Getenv("XDG_CACHE_HOME")
+	if cacheHome == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", i18n.Errorf("failed to get home directory: %w", err)
+		}
+		cacheHome = filepath.Join(homeDir, ".cache")
+	}
+	return filepath.Join(cacheHome, appName), nil
+}
+
+func NewCache(name, dataPath string) (*Cache, error) {
+	dir, err := CacheDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cache{
+		path:     filepath.Join(dir, name+".json"),
+		dataPath: dataPath,
+	}, nil
+}
+
+func (c *Cache) Load(value any, now time.Time) bool {
+	c.modTime, c.size = c.dataStamp()
+
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return false
+	}
+
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return false
+	}
+
+	if entry.DataModTime != c.modTime || entry.DataSize != c.size || !now.Before(entry.ValidUntil) {
+		return false
+	}
+
+	return json.Unmarshal(entry.Value, value) == nil
+}
+
+func (c *Cache) Save(value any, validUntil time.Time) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return i18n.Errorf("failed to marshal cache: %w", err)
+	}
+
+	data, err := json.Marshal(cacheEntry{
+		DataModTime: c.modTime,
+		DataSize:    c.size,
+		ValidUntil:  validUntil,
+		Value:       raw,
+	})
+	if err != nil {
+		return i18n.Errorf("failed to marshal cache: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return i18n.Errorf("failed to create cache directory: %w", err)
+	}
+
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return i18n.Errorf("failed to write cache file: %w", err)
+	}
+	if err := os.Rename(tmp, c.path); err != nil {
+		return i18n.Errorf("failed to write cache file: %w", err)
+	}
+	return nil
+}
+
+func (c *Cache) dataStamp() (int64, int64) {
+	info, err := os.Stat(c.dataPath)
+	if err != nil {
+		return 0, -1
+	}
+	return info.ModTime().UnixNano(), info.Size()
+}
@@ -2,12 +2,12 @@ package storage
 
 import (
 	"encoding/json"
-	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 	"time"
 
+	"urgent-reminder/internal/i18n"
 	"urgent-reminder/internal/models"
 )
 
@@ -23,14 +23,14 @@ type JSONStore struct {
 func NewJSONStore() (*JSONStore, error) {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get home directory: %w", err)
+		return nil, i18n.Errorf("failed to get home directory: %w", err)
 	}
 
 	dataHome := filepath.Join(homeDir, ".local", "share")
 	appDataPath := filepath.Join(dataHome, appName)
 
 	if err := os.MkdirAll(appDataPath, 0755); err != nil {
-		return nil, fmt.Errorf("failed to create data directory: %w", err)
+		return nil, i18n.Errorf("failed to create data directory: %w", err)
 	}
 
 	return &JSONStore{
@@ -48,7 +48,7 @@ func (s *JSONStore) LoadReminders() ([]*models.Reminder, error) {
 		if os.IsNotExist(err) {
 			return []*models.Reminder{}, nil
 		}
-		return nil, fmt.Errorf("failed to read reminders file: %w", err)
+		return nil, i18n.Errorf("failed to read reminders file: %w", err)
 	}
 
 	var reminders []*models.Reminder
@@ -56,19 +56,68 @@ func (s *JSONStore) LoadReminders() ([]*models.Reminder, error) {
 		if strings.Contains(err.Error(), "id") {
 			reminders, err = s.migrateOldFormat(data)
 			if err != nil {
-				return nil, fmt.Errorf("failed to migrate old format: %w", err)
+				return nil, i18n.Errorf("failed to migrate old format: %w", err)
 			}
 			if err := s.SaveReminders(reminders); err != nil {
-				return nil, fmt.Errorf("failed to save migrated reminders: %w", err)
+				return nil, i18n.Errorf("failed to save migrated reminders: %w", err)
 			}
 		} else {
-			return nil, fmt.Errorf("failed to parse reminders: %w", err)
+			return nil, i18n.Errorf("failed to parse reminders: %w", err)
 		}
 	}
 
+	assignMissingUUIDs(reminders)
+	resolveBlockers(reminders)
+
 	return reminders, nil
 }
 
+func assignMissingUUIDs(reminders []*models.Reminder) {
+	for _, r := range reminders {
+		if r.UUID == "" {
+			r.UUID = models.LegacyUUID(r)
+		}
+	}
+}
+
+func resolveBlockers(reminders []*models.Reminder) {
+	ids := make(map[string]int, len(reminders))
+	for _, r := range reminders {
+		ids[r.UUID] = r.ID
+	}
+
+	for _, r := range reminders {
+		var blockedBy []int
+		for _, uuid := range r.Blockers {
+			if id, ok := ids[uuid]; ok {
+				blockedBy = append(blockedBy, id)
+			}
+		}
+		r.BlockedBy = blockedBy
+		r.Blockers = nil
+	}
+}
+
+func storeBlockers(reminders []*models.Reminder) []*models.Reminder {
+	uuids := make(map[int]string, len(reminders))
+	for _, r := range reminders {
+		uuids[r.ID] = r.UUID
+	}
+
+	stored := make([]*models.Reminder, len(reminders))
+	for i, r := range reminders {
+		copied := *r
+		copied.Blockers = nil
+		for _, id := range r.BlockedBy {
+			if uuid, ok := uuids[id]; ok {
+				copied.Blockers = append(copied.Blockers, uuid)
+			}
+		}
+		stored[i] = &copied
+	}
+	return stored
+}
+
 type OldReminder struct {
 	ID           string    `json:"id"`
 	Description  string    `json:"description"`
@@ -100,13 +149,15 @@ func (s *JSONStore) migrateOldFormat(data []byte) ([]*models.Reminder, error) {
 }
 
 func (s *JSONStore) SaveReminders(reminders []*models.Reminder) error {
-	data, err := json.MarshalIndent(reminders, "", "  ")
+	assignMissingUUIDs(reminders)
+
+	data, err := json.MarshalIndent(storeBlockers(reminders), "", "  ")
 	if err != nil {
-		return fmt.Errorf("failed to marshal reminders: %w", err)
+		return i18n.Errorf("failed to marshal reminders: %w", err)
 	}
 
 	if err := os.WriteFile(s.dataPath, data, 0644); err != nil {
-		return fmt.Errorf("failed to write reminders file: %w", err)
+		return i18n.Errorf("failed to write reminders file: %w", err)
 	}
 
 	return nil
@@ -118,6 +169,10 @@ func (s *JSONStore) AddReminder(reminder *models.Reminder) error {
 		return err
 	}
 
+	if reminder.UUID == "" {
+		reminder.UUID = models.NewUUID()
+	}
+
 	reminders = append(reminders, reminder)
 	return s.SaveReminders(reminders)
 }
@@ -138,7 +193,7 @@ func (s *JSONStore) UpdateReminder(id int, updatedReminder *models.Reminder) err
 	}
 
 	if !found {
-		return fmt.Errorf("reminder with ID %d not found", id)
+		return i18n.Errorf("reminder with ID %d not found", id)
 	}
 
 	return s.SaveReminders(reminders)
@@ -161,7 +216,7 @@ func (s *JSONStore) DeleteReminder(id int) error {
 	}
 
 	if !found {
-		return fmt.Errorf("reminder with ID %d not found", id)
+		return i18n.Errorf("reminder with ID %d not found", id)
 	}
 
 	return s.SaveReminders(updatedReminders)
@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"urgent-reminder/internal/models"
+)
+
+func newTestStore(t *testing.T) *JSONStore {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+
+	store, err := NewJSONStore()
+	if err != nil {
+		t.Fatalf("NewJSONStore: %v", err)
+	}
+	return store
+}
+
+func TestBlockersStoredByUUID(t *testing.T) {
+	store := newTestStore(t)
+
+	due := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	blocker := models.NewReminder(1, "Blocker", due)
+	dependent := models.NewReminder(2, "Dependent", due)
+	dependent.BlockedBy = []int{1}
+
+	if err := store.SaveReminders([]*models.Reminder{blocker, dependent}); err != nil {
+		t.Fatalf("SaveReminders: %v", err)
+	}
+
+	data, err := os.ReadFile(store.GetDataPath())
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	if !strings.Contains(string(data), blocker.UUID) {
+		t.Errorf("data file does not reference the blocker UUID %s:\n%s", blocker.UUID, data)
+	}
+	if strings.Contains(string(data), "blocked_by") {
+		t.Errorf("data file stores numeric blocker IDs:\n%s", data)
+	}
+
+	reminders, err := store.LoadReminders()
+	if err != nil {
+		t.Fatalf("LoadReminders: %v", err)
+	}
+	if got := reminders[1].BlockedBy; !slices.Equal(got, []int{1}) {
+		t.Errorf("BlockedBy = %v, want [1]", got)
+	}
+}
+
+func TestBlockersSurviveReusedIDs(t *testing.T) {
+	store := newTestStore(t)
+
+	due := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	dependent := models.NewReminder(1, "Dependent", due)
+	dependent.BlockedBy = []int{2}
+	if err := store.SaveReminders([]*models.Reminder{dependent, models.NewReminder(2, "Blocker", due)}); err != nil {
+		t.Fatalf("SaveReminders: %v", err)
+	}
+
+	if err := store.DeleteReminder(2); err != nil {
+		t.Fatalf("DeleteReminder: %v", err)
+	}
+	id, err := store.GetNextID()
+	if err != nil {
+		t.Fatalf("GetNextID: %v", err)
+	}
+	if err := store.AddReminder(models.NewReminder(id, "Unrelated", due)); err != nil {
+		t.Fatalf("AddReminder: %v", err)
+	}
+
+	reminders, err := store.LoadReminders()
+	if err != nil {
+		t.Fatalf("LoadReminders: %v", err)
+	}
+	if got := reminders[0].BlockedBy; len(got) != 0 {
+		t.Errorf("BlockedBy = %v after ID %d was reused, want none", got, id)
+	}
+}
+
+func TestLoadDoesNotRewriteMissingUUIDs(t *testing.T) {
+	store := newTestStore(t)
+
+	legacy := `[{"id":1,"title":"Old reminder","due_date":"2026-01-15T00:00:00Z","created_at":"2025-06-01T10:00:00Z"}]`
+	if err := os.WriteFile(store.GetDataPath(), []byte(legacy), 0644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	first, err := store.LoadReminders()
+	if err != nil {
+		t.Fatalf("LoadReminders: %v", err)
+	}
+	second, err := store.LoadReminders()
+	if err != nil {
+		t.Fatalf("LoadReminders: %v", err)
+	}
+	if first[0].UUID == "" || first[0].UUID != second[0].UUID {
+		t.Errorf("UUIDs across loads = %q and %q, want the same non-empty UUID", first[0].UUID, second[0].UUID)
+	}
+
+	data, err := os.ReadFile(store.GetDataPath())
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	if string(data) != legacy {
+		t.Errorf("LoadReminders rewrote the data file:\n%s", data)
+	}
+}
@@ -0,0 +1,125 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"urgent-reminder/internal/config"
+	"urgent-reminder/internal/i18n"
+	"urgent-reminder/internal/models"
+)
+
+const templatesDir = "templates"
+
+var templateNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+type TemplateStore struct {
+	dir string
+}
+
+func NewTemplateStore() (*TemplateStore, error) {
+	configDir, err := config.Dir()
+	if err != nil {
+		return nil, err
+	}
+
+	return &TemplateStore{
+		dir: filepath.Join(configDir, templatesDir),
+	}, nil
+}
+
+func (s *TemplateStore) GetDir() string {
+	return s.dir
+}
+
+func (s *TemplateStore) LoadTemplate(name string) (*models.Template, error) {
+	if err := ValidateTemplateName(name); err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(s.path(name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, i18n.Errorf("template %q not found", name)
+		}
+		return nil, i18n.Errorf("failed to read template: %w", err)
+	}
+
+	var t models.Template
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, i18n.Errorf("failed to parse template %q: %w", name, err)
+	}
+	t.Name = name
+
+	return &t, nil
+}
+
+func (s *TemplateStore) SaveTemplate(t *models.Template) error {
+	if err := ValidateTemplateName(t.Name); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return i18n.Errorf("failed to create templates directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return i18n.Errorf("failed to marshal template: %w", err)
+	}
+
+	if err := os.WriteFile(s.path(t.Name), data, 0644); err != nil {
+		return i18n.Errorf("failed to write template file: %w", err)
+	}
+
+	return nil
+}
+
+func (s *TemplateStore) DeleteTemplate(name string) error {
+	if err := ValidateTemplateName(name); err != nil {
+		return err
+	}
+
+	if err := os.Remove(s.path(name)); err != nil {
+		if os.IsNotExist(err) {
+			return i18n.Errorf("template %q not found", name)
+		}
+		return i18n.Errorf("failed to delete template: %w", err)
+	}
+	return nil
+}
+
+func (s *TemplateStore) ListTemplates() ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, i18n.Errorf("failed to read templates directory: %w", err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		names = append(names, strings.TrimSuffix(entry.Name(), ".json"))
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (s *TemplateStore) path(name string) string {
+	return filepath.Join(s.dir, name+".json")
+}
+
+func ValidateTemplateName(name string) error {
+	if !templateNamePattern.MatchString(name) {
+		return i18n.Errorf("invalid template name %q, use lowercase letters, digits, '-' and '_'", name)
+	}
+	return nil
+}
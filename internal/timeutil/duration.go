@@ -0,0 +1,70 @@
+package timeutil
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"urgent-reminder/internal/i18n"
+)
+
+const (
+	Day  = 24 * time.Hour
+	Week = 7 * Day
+)
+
+func ParseDuration(input string) (time.Duration, error) {
+	s := strings.TrimSpace(strings.ToLower(input))
+	if s == "" {
+		return 0, i18n.Errorf("empty duration")
+	}
+
+	if d, err := time.ParseDuration(s); err == nil {
+		return d, nil
+	}
+
+	var total time.Duration
+	for s != "" {
+		i := 0
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		if i == 0 || i == len(s) {
+			return 0, i18n.Errorf("invalid duration %q, use e.g. 2h, 7d or 2w", input)
+		}
+
+		n, err := strconv.Atoi(s[:i])
+		if err != nil {
+			return 0, i18n.Errorf("invalid duration %q: %w", input, err)
+		}
+
+		var unit time.Duration
+		switch s[i] {
+		case 'w':
+			unit = Week
+		case 'd':
+			unit = Day
+		case 'h':
+			unit = time.Hour
+		case 'm':
+			unit = time.Minute
+		case 's':
+			unit = time.Second
+		default:
+			return 0, i18n.Errorf("invalid duration unit %q in %q", s[i], input)
+		}
+
+		total += time.Duration(n) * unit
+		s = s[i+1:]
+	}
+
+	return total, nil
+}
+
+func ParseDate(input string) (time.Time, error) {
+	date, err := time.ParseInLocation("2006-01-02", strings.TrimSpace(input), time.Local)
+	if err != nil {
+		return time.Time{}, i18n.Errorf("invalid date format %q, use YYYY-MM-DD", input)
+	}
+	return date, nil
+}
@@ -0,0 +1,77 @@
+package timeutil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{input: "90m", want: 90 * time.Minute},
+		{input: "2h30m", want: 2*time.Hour + 30*time.Minute},
+		{input: "7d", want: 7 * Day},
+		{input: "2w", want: 2 * Week},
+		{input: "1d12h", want: Day + 12*time.Hour},
+		{input: " 3D ", want: 3 * Day},
+		{input: "1w2d3h4m5s", want: Week + 2*Day + 3*time.Hour + 4*time.Minute + 5*time.Second},
+		{input: "", wantErr: true},
+		{input: "d", wantErr: true},
+		{input: "7", wantErr: true},
+		{input: "3y", wantErr: true},
+		{input: "2w-1d", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseDuration(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseDuration(%q) = %v, want error", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDuration(%q) error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDuration(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{input: "2026-01-15", want: time.Date(2026, 1, 15, 0, 0, 0, 0, time.Local)},
+		{input: " 2026-02-28 ", want: time.Date(2026, 2, 28, 0, 0, 0, 0, time.Local)},
+		{input: "2026-02-30", wantErr: true},
+		{input: "15/01/2026", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseDate(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseDate(%q) = %v, want error", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error: %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package timeutil
+
+import (
+	"time"
+
+	"urgent-reminder/internal/i18n"
+)
+
+func FormatDuration(d time.Duration) string {
+	if d < 0 {
+		d = -d
+	}
+
+	weeks := int(d / Week)
+	days := int(d / Day)
+	hours := int(d%Day) / int(time.Hour)
+	minutes := int(d%time.Hour) / int(time.Minute)
+
+	switch {
+	case weeks > 0:
+		return FormatDays(days)
+	case days > 0 && hours > 0:
+		return i18n.T("%dd %dh", days, hours)
+	case days > 0:
+		return i18n.T("%dd", days)
+	case hours > 0 && minutes > 0:
+		return i18n.T("%dh %dm", hours, minutes)
+	case hours > 0:
+		return i18n.T("%dh", hours)
+	default:
+		return i18n.T("%dm", minutes)
+	}
+}
+
+func FormatDays(days int) string {
+	if days < 0 {
+		days = -days
+	}
+
+	weeks := days / 7
+	days %= 7
+
+	switch {
+	case weeks > 0 && days > 0:
+		return i18n.T("%dw %dd", weeks, days)
+	case weeks > 0:
+		return i18n.T("%dw", weeks)
+	default:
+		return i18n.T("%dd", days)
+	}
+}
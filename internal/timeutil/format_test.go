@@ -0,0 +1,75 @@
+package timeutil
+
+import (
+	"testing"
+	"time"
+
+	"urgent-reminder/internal/i18n"
+)
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		input time.Duration
+		want  string
+	}{
+		{input: 0, want: "0m"},
+		{input: 45 * time.Minute, want: "45m"},
+		{input: 2 * time.Hour, want: "2h"},
+		{input: 2*time.Hour + 15*time.Minute, want: "2h 15m"},
+		{input: -(2*time.Hour + 15*time.Minute), want: "2h 15m"},
+		{input: 3 * Day, want: "3d"},
+		{input: 3*Day + 4*time.Hour + 30*time.Minute, want: "3d 4h"},
+		{input: Week, want: "1w"},
+		{input: 2*Week + 3*Day + 5*time.Hour, want: "2w 3d"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := FormatDuration(tt.input); got != tt.want {
+				t.Errorf("FormatDuration(%v) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatDays(t *testing.T) {
+	tests := []struct {
+		input int
+		want  string
+	}{
+		{input: 0, want: "0d"},
+		{input: 6, want: "6d"},
+		{input: 7, want: "1w"},
+		{input: 17, want: "2w 3d"},
+		{input: -9, want: "1w 2d"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := FormatDays(tt.input); got != tt.want {
+				t.Errorf("FormatDays(%d) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatDurationTranslatesUnits(t *testing.T) {
+	tests := []struct {
+		input time.Duration
+		want  string
+	}{
+		{input: 45 * time.Minute, want: "45min"},
+		{input: 2*time.Hour + 15*time.Minute, want: "2h 15min"},
+		{input: 3*Day + 4*time.Hour, want: "3d 4h"},
+		{input: 2*Week + 3*Day, want: "2sem 3d"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got := i18n.In(i18n.PortugueseBR, func() string { return FormatDuration(tt.input) })
+			if got != tt.want {
+				t.Errorf("FormatDuration(%v) in pt-BR = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}